@@ -42,12 +42,16 @@ import (
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/bootstrap"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/clean"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/create"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/delete"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/describe"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/diff"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/download"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/get"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/show"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/sign"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/tag"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/transfer"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/validate"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/verify"
 	"github.com/open-component-model/ocm/cmds/ocm/topics/common/attributes"
 	topicconfig "github.com/open-component-model/ocm/cmds/ocm/topics/common/config"
@@ -153,9 +157,13 @@ func NewCliCommand(ctx clictx.Context) *cobra.Command {
 
 	cmd.AddCommand(get.NewCommand(opts.Context))
 	cmd.AddCommand(create.NewCommand(opts.Context))
+	cmd.AddCommand(delete.NewCommand(opts.Context))
 	cmd.AddCommand(add.NewCommand(opts.Context))
 	cmd.AddCommand(sign.NewCommand(opts.Context))
+	cmd.AddCommand(tag.NewCommand(opts.Context))
 	cmd.AddCommand(verify.NewCommand(opts.Context))
+	cmd.AddCommand(diff.NewCommand(opts.Context))
+	cmd.AddCommand(validate.NewCommand(opts.Context))
 	cmd.AddCommand(show.NewCommand(opts.Context))
 	cmd.AddCommand(transfer.NewCommand(opts.Context))
 	cmd.AddCommand(describe.NewCommand(opts.Context))
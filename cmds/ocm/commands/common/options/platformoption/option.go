@@ -0,0 +1,104 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package platformoption
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/options"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+)
+
+func From(o options.OptionSetProvider) *Option {
+	var opt *Option
+	o.AsOptionSet().Get(&opt)
+	return opt
+}
+
+func New() *Option {
+	return &Option{}
+}
+
+// NewForComponentVersions provides the option for commands transferring
+// component versions, which additionally offers to filter signed
+// component versions.
+func NewForComponentVersions() *Option {
+	return &Option{components: true}
+}
+
+type Option struct {
+	components   bool
+	List         []string
+	Platforms    []*artdesc.Platform
+	FilterSigned bool
+}
+
+var (
+	_ transferhandler.TransferOption = (*Option)(nil)
+	_ options.SimpleOptionCompleter  = (*Option)(nil)
+)
+
+func (o *Option) AddFlags(fs *pflag.FlagSet) {
+	fs.StringArrayVarP(&o.List, "platform", "", nil, "restrict multi-arch images to platforms (<os>/<architecture>[/<variant>])")
+	if o.components {
+		fs.BoolVarP(&o.FilterSigned, "filter-signed", "", false, "permit platform filtering for signed component versions")
+	}
+}
+
+func (o *Option) Complete() error {
+	o.Platforms = nil
+	for _, s := range o.List {
+		p, err := artdesc.ParsePlatform(s)
+		if err != nil {
+			return err
+		}
+		o.Platforms = append(o.Platforms, p)
+	}
+	return nil
+}
+
+func (o *Option) Usage() string {
+	s := `
+With option <code>--platform</code> multi-arch images (OCI indices) can be
+restricted to the manifests for the given platforms
+(<code>&lt;os>/&lt;architecture>[/&lt;variant>]</code>). If several manifests
+match, a reduced index is stored in the target. If only a single manifest
+matches, this manifest is stored instead of the index. For component versions,
+this applies to resources of type <code>ociImage</code> transferred by value.
+`
+	if o.components {
+		s += `
+Filtering changes the digests of the affected resources, which invalidates
+the signatures of signed component versions. Therefore, the transfer of signed
+component versions is rejected, if filtering would be required. With option
+<code>--filter-signed</code> the filtering is permitted anyway, and the
+transferred component versions have to be signed again.
+`
+	}
+	return s
+}
+
+func (o *Option) ApplyTransferOption(opts transferhandler.TransferOptions) error {
+	if len(o.Platforms) == 0 {
+		return nil
+	}
+	err := standard.Platforms(o.Platforms...).ApplyTransferOption(opts)
+	if err != nil || !o.FilterSigned {
+		return err
+	}
+	return standard.FilterSigned().ApplyTransferOption(opts)
+}
@@ -12,12 +12,14 @@
 //  See the License for the specific language governing permissions and
 //  limitations under the License.
 
-package rsakeypair
+package keypair
 
 import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -34,14 +36,46 @@ import (
 	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/out"
 	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/ecdsa"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/ed25519"
 	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
 )
 
 var (
-	Names = names.RSAKeyPair
+	Names = names.KeyPair
 	Verb  = verbs.Create
 )
 
+const (
+	TYPE_RSA     = "rsa"
+	TYPE_ECDSA   = "ecdsa"
+	TYPE_ED25519 = "ed25519"
+)
+
+// KeyType describes the generation and PEM encoding of
+// keys for a dedicated signature handler.
+type KeyType struct {
+	Creator interface {
+		CreateKeyPair() (priv interface{}, pub interface{}, err error)
+	}
+	Writer func(key interface{}, w io.Writer) error
+}
+
+var KeyTypes = map[string]KeyType{
+	TYPE_RSA:     {rsa.Handler{}, rsa.WriteKeyData},
+	TYPE_ECDSA:   {ecdsa.Handler{}, ecdsa.WriteKeyData},
+	TYPE_ED25519: {ed25519.Handler{}, ed25519.WriteKeyData},
+}
+
+func keyTypeNames() []string {
+	var list []string
+	for n := range KeyTypes {
+		list = append(list, n)
+	}
+	sort.Strings(list)
+	return list
+}
+
 type Command struct {
 	utils.BaseCommand
 
@@ -49,6 +83,8 @@ type Command struct {
 	MoreIssuers []string
 	priv        string
 	pub         string
+	Type        string
+	keyType     KeyType
 
 	attrs  map[string]string
 	cacert string
@@ -69,11 +105,21 @@ func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
 func (o *Command) ForName(name string) *cobra.Command {
 	return &cobra.Command{
 		Use:   "[<private key file> [<public key file>]] {<subject-attribute>=<value>}",
-		Short: "create RSA public key pair",
+		Short: "create public key pair",
 		Long: `
-Create an RSA public key pair and save to files.
+Create a public key pair and save to files.
 
-The default for the filename to store the private key is <code>rsa.priv</code>.
+The key type is selected with option <code>--type</code>. The following
+types are supported:
+- <code>rsa</code> (default): RSA keys for algorithm <code>` + rsa.Algorithm + `</code>
+- <code>ecdsa</code>: ECDSA P-256 keys for algorithms <code>` + ecdsa.Algorithm + `</code> and <code>sigstore</code>
+- <code>ed25519</code>: Ed25519 keys for algorithm <code>` + ed25519.Algorithm + `</code>
+
+The keys are written PEM encoded, which is accepted by the
+<code>--private-key</code> and <code>--public-key</code> options of the
+signing commands and by the signing configuration.
+
+The default for the filename to store the private key is <code>&lt;type>.priv</code>.
 If no public key file is specified, its name will be derived from the filename for
 the private key (suffix <code>.pub</code> for public key or <code>.cert</code> for certificate).
 If a certificate authority is given (<code>--cacert</code>) the public key
@@ -93,6 +139,7 @@ For signing the public key the following subject attributes are supported:
 	`,
 		Example: `
 $ ocm create rsakeypair mandelsoft.priv mandelsoft.cert issuer=mandelsoft
+$ ocm create keypair --type ed25519 mandelsoft.priv
 `,
 	}
 }
@@ -101,6 +148,7 @@ func (o *Command) AddFlags(set *pflag.FlagSet) {
 	set.StringVarP(&o.cacert, "cacert", "", "", "certificate authority to sign public key")
 	set.StringVarP(&o.cakey, "cakey", "", "", "private key for certificate authority")
 	set.DurationVarP(&o.Validity, "validity", "", 10*24*365*time.Hour, "certificate validity")
+	set.StringVarP(&o.Type, "type", "t", TYPE_RSA, "key type ("+strings.Join(keyTypeNames(), ", ")+")")
 }
 
 func (o *Command) FilterSettings(args ...string) []string {
@@ -113,6 +161,15 @@ func (o *Command) Complete(args []string) error {
 	if len(args) > 2 {
 		return errors.Newf("only a maximum of two filenames possible")
 	}
+	if o.Type == "" {
+		o.Type = TYPE_RSA
+	}
+	o.Type = strings.ToLower(o.Type)
+	t, ok := KeyTypes[o.Type]
+	if !ok {
+		return errors.ErrUnknown("key type", o.Type)
+	}
+	o.keyType = t
 	if o.attrs != nil && len(o.attrs) > 0 {
 		var subject pkix.Name
 		for k, v := range o.attrs {
@@ -159,15 +216,15 @@ func (o *Command) Complete(args []string) error {
 		o.CACert = cert
 	}
 	if o.cakey != "" {
-		key, err := parse.ParsePrivateKey(o.cakey)
+		key, err := parsePrivateKey(o.cakey)
 		if err != nil {
-			data, err := vfs.ReadFile(o.Context.FileSystem(), o.cacert)
+			data, err := vfs.ReadFile(o.Context.FileSystem(), o.cakey)
 			if err != nil {
-				return errors.Wrapf(err, "cannot read provate key file %q", o.cacert)
+				return errors.Wrapf(err, "cannot read private key file %q", o.cakey)
 			}
-			key, err = parse.ParsePrivateKey(string(data))
+			key, err = parsePrivateKey(string(data))
 			if err != nil {
-				return errors.Wrapf(err, "unknown private key in file %q", o.cacert)
+				return errors.Wrapf(err, "unknown private key in file %q", o.cakey)
 			}
 		}
 		o.CAKey = key
@@ -187,7 +244,7 @@ func (o *Command) Complete(args []string) error {
 	if len(args) > 0 {
 		o.priv = args[0]
 	} else {
-		o.priv = "rsa.priv"
+		o.priv = o.Type + ".priv"
 	}
 	if len(args) > 1 {
 		o.pub = args[1]
@@ -207,7 +264,7 @@ func (o *Command) Complete(args []string) error {
 }
 
 func (o *Command) Run() error {
-	priv, pub, err := rsa.Handler{}.CreateKeyPair()
+	priv, pub, err := o.keyType.Creator.CreateKeyPair()
 	if err != nil {
 		return err
 	}
@@ -228,7 +285,7 @@ func (o *Command) Run() error {
 	if err := o.WriteKey(pub, o.pub); err != nil {
 		return errors.Wrapf(err, "failed to write public key file %q", o.pub)
 	}
-	out.Outf(o.Context, "created rsa key pair %s[%s]\n", o.priv, o.pub)
+	out.Outf(o.Context, "created %s key pair %s[%s]\n", o.Type, o.priv, o.pub)
 	return nil
 }
 
@@ -242,7 +299,7 @@ func (o *Command) WriteKey(key interface{}, path string) error {
 		block := &pem.Block{Type: "CERTIFICATE", Bytes: certdata}
 		err = pem.Encode(fd, block)
 	} else {
-		err = rsa.WriteKeyData(key, fd)
+		err = o.keyType.Writer(key, fd)
 	}
 	if err != nil {
 		fd.Close()
@@ -255,3 +312,14 @@ func (o *Command) WriteKey(key interface{}, path string) error {
 	}
 	return o.FileSystem().Chmod(path, 0o400)
 }
+
+// parsePrivateKey parses a PEM encoded private key of any supported key type.
+func parsePrivateKey(data string) (interface{}, error) {
+	key, err := parse.ParsePrivateKey(data)
+	if err != nil {
+		if k, err2 := ed25519.ParsePrivateKey([]byte(data)); err2 == nil {
+			return k, nil
+		}
+	}
+	return key, err
+}
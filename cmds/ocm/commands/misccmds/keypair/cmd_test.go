@@ -12,7 +12,7 @@
 //  See the License for the specific language governing permissions and
 //  limitations under the License.
 
-package rsakeypair_test
+package keypair_test
 
 import (
 	"bytes"
@@ -24,6 +24,9 @@ import (
 
 	"github.com/opencontainers/go-digest"
 
+	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/ecdsa"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/ed25519"
 	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
 )
 
@@ -85,4 +88,60 @@ created rsa key pair key.priv[key.cert]
 		err = rsa.Handler{}.Verify(d.Hex(), 0, sig, pub)
 		Expect(err).To(Succeed())
 	})
+	DescribeTable("create typed key pair", func(typ string, handler signing.SignatureHandler, mediatype string, attrs ...string) {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute(append([]string{"create", "keypair", "--type", typ, "key.priv"}, attrs...)...)).To(Succeed())
+		suf := "pub"
+		if len(attrs) > 0 {
+			suf = "cert"
+		}
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+created ` + typ + ` key pair key.priv[key.` + suf + `]
+`))
+		priv, err := env.ReadFile("key.priv")
+		Expect(err).To(Succeed())
+		pub, err := env.ReadFile("key." + suf)
+		Expect(err).To(Succeed())
+
+		d := digest.FromBytes([]byte("digest"))
+		sig, err := handler.Sign(d.Hex(), 0, ISSUER, priv)
+		Expect(err).To(Succeed())
+		Expect(sig.Algorithm).To(Equal(handler.Algorithm()))
+		Expect(sig.MediaType).To(Equal(mediatype))
+
+		err = handler.Verify(d.Hex(), 0, sig, pub)
+		Expect(err).To(Succeed())
+	},
+		Entry("ed25519", "ed25519", ed25519.Handler{}, ed25519.MediaType),
+		Entry("self-signed ed25519", "ed25519", ed25519.Handler{}, ed25519.MediaType, "CN=mandelsoft"),
+		Entry("ecdsa", "ecdsa", ecdsa.Handler{}, ecdsa.MediaType),
+		Entry("self-signed ecdsa", "ecdsa", ecdsa.Handler{}, ecdsa.MediaType, "CN=mandelsoft"),
+	)
+
+	It("uses key type for default file name", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("create", "keypair", "-t", "ed25519")).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+created ed25519 key pair ed25519.priv[ed25519.pub]
+`))
+	})
+
+	It("signs public key with ed25519 certificate authority", func() {
+		Expect(env.Execute("create", "keypair", "-t", "ed25519", "ca.priv", "CN=authority")).To(Succeed())
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("create", "keypair", "-t", "ed25519", "--cacert", "ca.cert", "--cakey", "ca.priv", "key.priv", "CN=mandelsoft")).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+created ed25519 key pair key.priv[key.cert]
+`))
+		data, err := env.ReadFile("key.cert")
+		Expect(err).To(Succeed())
+		cert, err := signing.ParseCertificate(data)
+		Expect(err).To(Succeed())
+		Expect(cert.Issuer.CommonName).To(Equal("authority"))
+		Expect(cert.Subject.CommonName).To(Equal("mandelsoft"))
+	})
+
+	It("rejects unknown key type", func() {
+		Expect(env.Execute("create", "keypair", "-t", "dsa")).To(MatchError(`key type "dsa" is unknown`))
+	})
 })
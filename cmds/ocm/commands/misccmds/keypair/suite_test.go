@@ -12,7 +12,7 @@
 //  See the License for the specific language governing permissions and
 //  limitations under the License.
 
-package rsakeypair_test
+package keypair_test
 
 import (
 	"testing"
@@ -23,5 +23,5 @@ import (
 
 func TestConfig(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "RSA Key Pair")
+	RunSpecs(t, "Key Pair")
 }
@@ -15,6 +15,6 @@
 package names
 
 var (
-	RSAKeyPair  = []string{"rsakeypair", "rsa"}
+	KeyPair     = []string{"keypair", "rsakeypair", "rsa"}
 	Credentials = []string{"credentials", "creds", "cred"}
 )
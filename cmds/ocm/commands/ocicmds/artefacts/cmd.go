@@ -17,9 +17,11 @@ package artefacts
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artefacts/delete"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artefacts/describe"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artefacts/download"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artefacts/get"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artefacts/tag"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artefacts/transfer"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/names"
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
@@ -38,5 +40,7 @@ func NewCommand(ctx clictx.Context) *cobra.Command {
 	cmd.AddCommand(describe.NewCommand(ctx, describe.Verb))
 	cmd.AddCommand(transfer.NewCommand(ctx, transfer.Verb))
 	cmd.AddCommand(download.NewCommand(ctx, download.Verb))
+	cmd.AddCommand(delete.NewCommand(ctx, delete.Verb))
+	cmd.AddCommand(tag.NewCommand(ctx, tag.Verb))
 	return cmd
 }
@@ -0,0 +1,155 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package delete
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common/handlers/artefacthdlr"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common/options/repooption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/output"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/out"
+)
+
+var (
+	Names = names.Artefacts
+	Verb  = verbs.Delete
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	TagsOnly bool
+	Refs     []string
+}
+
+// NewCommand creates a new delete command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New())}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "[<options>] {<artefact-reference>}",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "delete artefacts or tags",
+		Long: `
+Delete artefacts from an OCI repository. The artefacts must be specified
+with a tag or digest, a repository name alone is not sufficient.
+
+By default, the manifest of the artefact is deleted, together with all tags
+referring to it. Blobs not used anymore are removed, also, if supported by the
+repository type. With option <code>--tags-only</code> only the tag given by the
+reference is removed and the artefact is kept.
+
+Tag deletion is not supported by all OCI registries.
+`,
+		Example: `
+$ ocm delete artefact ghcr.io/mandelsoft/kubelink:v1.0.0
+$ ocm delete artefact --repo ctf.tgz --tags-only mandelsoft/kubelink:latest
+`,
+	}
+}
+
+func (o *Command) AddFlags(fs *pflag.FlagSet) {
+	o.BaseCommand.AddFlags(fs)
+	fs.BoolVarP(&o.TagsOnly, "tags-only", "", false, "delete only the tag, keep the artefact")
+}
+
+func (o *Command) Complete(args []string) error {
+	o.Refs = args
+	for _, r := range args {
+		var err error
+		versioned, tagged := false, false
+		if repooption.From(o).Spec != "" {
+			var spec oci.ArtSpec
+			spec, err = oci.ParseArt(r)
+			versioned, tagged = spec.IsVersion(), spec.Tag != nil
+		} else {
+			var spec oci.RefSpec
+			spec, err = oci.ParseRef(r)
+			versioned, tagged = spec.IsVersion(), spec.IsTagged()
+		}
+		if err != nil {
+			return errors.Wrapf(err, "reference %q", r)
+		}
+		if !versioned {
+			return errors.Newf("no tag or digest specified for artefact %q", r)
+		}
+		if o.TagsOnly && !tagged {
+			return errors.Newf("no tag specified for artefact %q", r)
+		}
+	}
+	return nil
+}
+
+func (o *Command) Run() error {
+	session := oci.NewSession(nil)
+	defer session.Close()
+	err := o.ProcessOnOptions(common.CompleteOptionsWithContext(o.Context, session))
+	if err != nil {
+		return err
+	}
+	handler := artefacthdlr.NewTypeHandler(o.Context.OCI(), session, repooption.From(o).Repository)
+	return utils.HandleOutput(&action{cmd: o}, handler, utils.StringElemSpecs(o.Refs...)...)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type action struct {
+	data []*artefacthdlr.Object
+	cmd  *Command
+}
+
+var _ output.Output = (*action)(nil)
+
+func (d *action) Add(e interface{}) error {
+	d.data = append(d.data, e.(*artefacthdlr.Object))
+	return nil
+}
+
+func (d *action) Close() error {
+	return nil
+}
+
+func (d *action) Out() error {
+	list := errors.ErrListf("deleting artefacts")
+	for _, e := range d.data {
+		ref := e.Spec.String()
+		err := d.Delete(e)
+		if err != nil {
+			list.Add(errors.Wrapf(err, "%s", ref))
+			out.Outf(d.cmd.Context, "%s failed: %s\n", ref, err)
+		} else {
+			out.Outf(d.cmd.Context, "%s deleted\n", ref)
+		}
+	}
+	return list.Result()
+}
+
+func (d *action) Delete(o *artefacthdlr.Object) error {
+	if d.cmd.TagsOnly {
+		return o.Namespace.DeleteTags(*o.Spec.Tag)
+	}
+	return o.Namespace.DeleteArtefact(o.Spec.Version())
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/destoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/formatoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/platformoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common/handlers/artefacthdlr"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common/options/repooption"
@@ -34,6 +35,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
 	"github.com/open-component-model/ocm/pkg/contexts/oci"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/transfer"
 	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/out"
 )
@@ -51,7 +53,7 @@ type Command struct {
 
 // NewCommand creates a new download command.
 func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
-	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New(), output.OutputOptions(outputs, destoption.New(), &formatoption.Option{}))}, utils.Names(Names, names...)...)
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New(), output.OutputOptions(outputs, destoption.New(), &formatoption.Option{}, platformoption.New()))}, utils.Names(Names, names...)...)
 }
 
 func (o *Command) ForName(name string) *cobra.Command {
@@ -154,7 +156,11 @@ func (d *download) Save(o *artefacthdlr.Object, f string) error {
 		return err
 	}
 	defer set.Close()
-	err = artefactset.TransferArtefact(art, set)
+	if platforms := platformoption.From(d.opts).Platforms; len(platforms) > 0 && art.IsIndex() {
+		digest, err = transfer.TransferIndexForPlatforms(art.IndexAccess(), set, platforms)
+	} else {
+		err = artefactset.TransferArtefact(art, set)
+	}
 	if err != nil {
 		return err
 	}
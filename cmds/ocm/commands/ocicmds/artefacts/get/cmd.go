@@ -46,7 +46,7 @@ type Command struct {
 
 // NewCommand creates a new artefact command.
 func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
-	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New(), output.OutputOptions(outputs, &Attached{}, closureoption.New("index")))}, utils.Names(Names, names...)...)
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New(), output.OutputOptions(outputs, &Attached{}, &Referrers{}, closureoption.New("index")))}, utils.Names(Names, names...)...)
 }
 
 func (o *Command) ForName(name string) *cobra.Command {
@@ -56,6 +56,10 @@ func (o *Command) ForName(name string) *cobra.Command {
 		Long: `
 Get lists all artefact versions specified, if only a repository is specified
 all tagged artefacts are listed.
+
+With option <code>--referrers</code> the artefacts referring to the listed
+artefacts by their subject field (OCI referrers API), like signatures
+or SBOMs, are listed, also.
 	`,
 		Example: `
 $ ocm get artefact ghcr.io/mandelsoft/kubelink
@@ -89,6 +93,7 @@ func OutputChainFunction() output.ChainFunction {
 	return func(opts *output.Options) processing.ProcessChain {
 		chain := closureoption.Closure(opts, artefacthdlr.ClosureExplode, artefacthdlr.Sort)
 		chain = processing.Append(chain, artefacthdlr.ExplodeAttached, AttachedFrom(opts))
+		chain = processing.Append(chain, artefacthdlr.ExplodeReferrers, ReferrersFrom(opts))
 		return processing.Append(chain, artefacthdlr.Clean, options.Or(closureoption.From(opts), output.OutputModeCondition(opts, "tree")))
 	}
 }
@@ -42,3 +42,26 @@ func (a *Attached) IsTrue() bool {
 func (a *Attached) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVarP(&a.Flag, "attached", "a", false, "show attached artefacts")
 }
+
+func ReferrersFrom(o options.OptionSetProvider) *Referrers {
+	var opt *Referrers
+	o.AsOptionSet().Get(&opt)
+	return opt
+}
+
+type Referrers struct {
+	Flag bool
+}
+
+var (
+	_ options.Condition = (*Referrers)(nil)
+	_ options.Options   = (*Referrers)(nil)
+)
+
+func (a *Referrers) IsTrue() bool {
+	return a.Flag
+}
+
+func (a *Referrers) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVarP(&a.Flag, "referrers", "", false, "show referring artefacts (OCI referrers API)")
+}
@@ -0,0 +1,124 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package tag
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common/handlers/artefacthdlr"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common/options/repooption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/out"
+)
+
+var (
+	Names = names.Artefacts
+	Verb  = verbs.Tag
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	Ref  string
+	Tags []string
+}
+
+// NewCommand creates a new tag command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New())}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "[<options>] <artefact-reference> {<tag>}",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "add tags to an artefact",
+		Long: `
+Add additional tags to an artefact in an OCI repository. The artefact must be
+specified with a tag or digest. Tags already used by other artefacts in the
+same repository are moved to the given artefact.
+`,
+		Example: `
+$ ocm tag artefact ghcr.io/mandelsoft/kubelink:v1.0.0 latest stable
+$ ocm tag artefact --repo ctf.tgz mandelsoft/kubelink@sha256:... v1.0.0
+`,
+	}
+}
+
+func (o *Command) Complete(args []string) error {
+	o.Ref = args[0]
+	o.Tags = args[1:]
+
+	var err error
+	versioned := false
+	if repooption.From(o).Spec != "" {
+		var spec oci.ArtSpec
+		spec, err = oci.ParseArt(o.Ref)
+		versioned = spec.IsVersion()
+	} else {
+		var spec oci.RefSpec
+		spec, err = oci.ParseRef(o.Ref)
+		versioned = spec.IsVersion()
+	}
+	if err != nil {
+		return errors.Wrapf(err, "reference %q", o.Ref)
+	}
+	if !versioned {
+		return errors.Newf("no tag or digest specified for artefact %q", o.Ref)
+	}
+	for _, t := range o.Tags {
+		if ok, _ := artdesc.IsDigest(t); ok {
+			return errors.ErrInvalid("tag", t)
+		}
+	}
+	return nil
+}
+
+func (o *Command) Run() error {
+	session := oci.NewSession(nil)
+	defer session.Close()
+	err := o.ProcessOnOptions(common.CompleteOptionsWithContext(o.Context, session))
+	if err != nil {
+		return err
+	}
+	handler := artefacthdlr.NewTypeHandler(o.Context.OCI(), session, repooption.From(o).Repository)
+	objs, err := handler.Get(utils.StringSpec(o.Ref))
+	if err != nil {
+		return err
+	}
+	if len(objs) != 1 {
+		return errors.ErrNotFound(oci.KIND_OCIARTEFACT, o.Ref)
+	}
+	obj := objs[0].(*artefacthdlr.Object)
+	blob, err := obj.Artefact.Blob()
+	if err != nil {
+		return err
+	}
+	err = obj.Namespace.AddTags(blob.Digest(), o.Tags...)
+	if err != nil {
+		return errors.Wrapf(err, "%s", o.Ref)
+	}
+	out.Outf(o.Context, "%s tagged with %s\n", o.Ref, strings.Join(o.Tags, ", "))
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package tag_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const ARCH = "/tmp/ctf"
+const VERSION1 = "v1"
+const VERSION2 = "v2"
+const NS = "mandelsoft/test"
+
+var _ = Describe("Test Environment", func() {
+	var env *TestEnv
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+		env.OCICommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Namespace(NS, func() {
+				env.Manifest(VERSION1, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata")
+					})
+				})
+				env.Manifest(VERSION2, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "otherdata")
+					})
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("adds tags", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("tag", "artefact", ARCH+"//"+NS+":"+VERSION1, "latest", "stable")).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+/tmp/ctf//mandelsoft/test:v1 tagged with latest, stable
+`))
+
+		buf.Reset()
+		Expect(env.CatchOutput(buf).Execute("get", "artefact", ARCH+"//"+NS)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+REGISTRY REPOSITORY      KIND     TAG    DIGEST
+/tmp/ctf mandelsoft/test manifest latest sha256:2c3e2c59e0ac9c99864bf0a9f9727c09f21a66080f9f9b03b36a2dad3cce6ff9
+/tmp/ctf mandelsoft/test manifest stable sha256:2c3e2c59e0ac9c99864bf0a9f9727c09f21a66080f9f9b03b36a2dad3cce6ff9
+/tmp/ctf mandelsoft/test manifest v1     sha256:2c3e2c59e0ac9c99864bf0a9f9727c09f21a66080f9f9b03b36a2dad3cce6ff9
+/tmp/ctf mandelsoft/test manifest v2     sha256:60b245b3de64c43b18489e9c3cf177402f9bd18ab62f8cc6653e2fc2e3a5fc39
+`))
+	})
+
+	It("moves tag", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("tag", "artefact", ARCH+"//"+NS+":"+VERSION1, VERSION2)).To(Succeed())
+
+		buf.Reset()
+		Expect(env.CatchOutput(buf).Execute("get", "artefact", ARCH+"//"+NS)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+REGISTRY REPOSITORY      KIND     TAG DIGEST
+/tmp/ctf mandelsoft/test manifest v1  sha256:2c3e2c59e0ac9c99864bf0a9f9727c09f21a66080f9f9b03b36a2dad3cce6ff9
+/tmp/ctf mandelsoft/test manifest v2  sha256:2c3e2c59e0ac9c99864bf0a9f9727c09f21a66080f9f9b03b36a2dad3cce6ff9
+`))
+	})
+})
@@ -22,6 +22,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/platformoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common/handlers/artefacthdlr"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common/options/repooption"
@@ -30,6 +31,7 @@ import (
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
 	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/transfer"
 	"github.com/open-component-model/ocm/pkg/errors"
@@ -45,13 +47,14 @@ type Command struct {
 	utils.BaseCommand
 
 	TransferRepo bool
+	Referrers    bool
 
 	Refs   []string
 	Target string
 }
 
 func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
-	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New())}, utils.Names(Names, names...)...)
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New(), platformoption.New())}, utils.Names(Names, names...)...)
 }
 
 func (o *Command) ForName(name string) *cobra.Command {
@@ -76,12 +79,17 @@ Sources may be specified as
 - dedicated artefacts with repository and version or tag
 - repository (without version), which is resolved to all available tags
 - registry, if the specified registry implementation supports a namespace/repository lister,
-  which is not the case for registries conforming to the OCI distribution specification.`,
+  which is not the case for registries conforming to the OCI distribution specification.
+
+With option <code>--referrers</code> the artefacts referring to the transferred
+artefacts by their subject field (OCI referrers API), like signatures or SBOMs,
+are transferred, also.`,
 		Example: `
 $ ocm oci artefact transfer ghcr.io/mandelsoft/kubelink:v1.0.0 gcr.io
 $ ocm oci artefact transfer ghcr.io/mandelsoft/kubelink gcr.io
 $ ocm oci artefact transfer ghcr.io/mandelsoft/kubelink gcr.io/my-project
 $ ocm oci artefact transfer /tmp/ctf gcr.io/my-project
+$ ocm oci artefact transfer --platform linux/arm64 ghcr.io/mandelsoft/kubelink:v1.0.0 gcr.io
 `,
 	}
 }
@@ -89,6 +97,7 @@ $ ocm oci artefact transfer /tmp/ctf gcr.io/my-project
 func (o *Command) AddFlags(flags *pflag.FlagSet) {
 	o.BaseCommand.AddFlags(flags)
 	flags.BoolVarP(&o.TransferRepo, "repo-name", "R", false, "transfer repository name")
+	flags.BoolVarP(&o.Referrers, "referrers", "", false, "transfer referrers of artefacts")
 }
 
 func (o *Command) Complete(args []string) error {
@@ -111,6 +120,8 @@ func (o *Command) Run() error {
 	if err != nil {
 		return err
 	}
+	a.Referrers = o.Referrers
+	a.Platforms = platformoption.From(o).Platforms
 
 	handler := artefacthdlr.NewTypeHandler(o.Context.OCI(), session, repooption.From(o).Repository)
 
@@ -124,6 +135,8 @@ type action struct {
 	Registry     oci.Repository
 	Ref          oci.RefSpec
 	TransferRepo bool
+	Referrers    bool
+	Platforms    []*artdesc.Platform
 
 	srcs         []*artefacthdlr.Object
 	repositories map[string]map[string]digest.Digest
@@ -226,7 +239,11 @@ func (a *action) handleArtefact(src *artefacthdlr.Object) error {
 		tgt.Tag = &tag
 	}
 	out.Outf(a.Context, "copying %s to %s...\n", &src.Spec, &tgt)
-	err = transfer.TransferArtefact(src.Artefact, ns, tag)
+	opts := &transfer.Options{Platforms: a.Platforms}
+	if a.Referrers {
+		opts.Referrers = src.Namespace
+	}
+	err = transfer.TransferArtefactWithOptions(src.Artefact, ns, opts, tag)
 	if err == nil {
 		a.copied++
 	}
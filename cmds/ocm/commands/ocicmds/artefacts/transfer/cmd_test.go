@@ -23,6 +23,8 @@ import (
 	. "github.com/open-component-model/ocm/pkg/testutils"
 
 	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/mime"
 )
@@ -137,4 +139,135 @@ copied 1 from 1 artefact(s) and 1 repositories
 `))
 		Expect(env.ReadFile(OUT + "/" + ctf.ArtefactIndexFileName)).To(Equal([]byte("{\"schemaVersion\":1,\"artefacts\":[{\"repository\":\"mandelsoft/test\",\"tag\":\"v1\",\"digest\":\"sha256:2c3e2c59e0ac9c99864bf0a9f9727c09f21a66080f9f9b03b36a2dad3cce6ff9\"}]}")))
 	})
+
+	It("transfers an artefact with referrers", func() {
+		env.OCICommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Namespace(NS, func() {
+				subject := env.Manifest(VERSION, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata")
+					})
+				})
+				env.Manifest("", func() {
+					env.ArtifactType("application/vnd.example.sbom")
+					env.Subject(subject)
+					env.Config(func() {
+						env.BlobStringData(artdesc.MediaTypeEmptyJSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_JSON, "{\"sbom\":\"test\"}")
+					})
+				})
+			})
+		})
+
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("transfer", "artefact", "--referrers", ARCH+"//"+NS+":"+VERSION, "directory::"+OUT)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+copying /tmp/ctf//mandelsoft/test:v1 to directory::` + OUT + `//mandelsoft/test:v1...
+copied 1 from 1 artefact(s) and 1 repositories
+`))
+		buf.Reset()
+		Expect(env.CatchOutput(buf).Execute("get", "artefact", "--referrers", "-o", "tree", OUT+"//"+NS)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+NESTING                                REGISTRY REPOSITORY      KIND     TAG DIGEST
+└─ ⊗                                   /tmp/res mandelsoft/test manifest v1  sha256:2c3e2c59e0ac9c99864bf0a9f9727c09f21a66080f9f9b03b36a2dad3cce6ff9
+   └─ application/vnd.example.sbom     /tmp/res mandelsoft/test manifest -   sha256:fdffbc5397892438be9a58e11bf73602310acca3a87001fc2377deef221e851e
+`))
+	})
+
+	Context("multi-arch", func() {
+		var amd64, arm64 *artdesc.Descriptor
+
+		BeforeEach(func() {
+			env.OCICommonTransport(ARCH, accessio.FormatDirectory, func() {
+				env.Namespace(NS, func() {
+					env.Index(VERSION, func() {
+						amd64 = env.Manifest("", func() {
+							env.Platform("linux", "amd64")
+							env.Config(func() {
+								env.BlobStringData(mime.MIME_JSON, "{}")
+							})
+							env.Layer(func() {
+								env.BlobStringData(mime.MIME_TEXT, "amd64")
+							})
+						})
+						arm64 = env.Manifest("", func() {
+							env.Platform("linux", "arm64", "v8")
+							env.Config(func() {
+								env.BlobStringData(mime.MIME_JSON, "{}")
+							})
+							env.Layer(func() {
+								env.BlobStringData(mime.MIME_TEXT, "arm64")
+							})
+						})
+						env.Manifest("", func() {
+							env.Platform("windows", "amd64")
+							env.Config(func() {
+								env.BlobStringData(mime.MIME_JSON, "{}")
+							})
+							env.Layer(func() {
+								env.BlobStringData(mime.MIME_TEXT, "windows")
+							})
+						})
+					})
+				})
+			})
+		})
+
+		It("transfers a single platform as manifest", func() {
+			buf := bytes.NewBuffer(nil)
+			Expect(env.CatchOutput(buf).Execute("transfer", "artefact", "--platform", "linux/arm64", ARCH+"//"+NS+":"+VERSION, "directory::"+OUT)).To(Succeed())
+			Expect(buf.String()).To(StringEqualTrimmedWithContext(
+				`
+copying /tmp/ctf//mandelsoft/test:v1 to directory::` + OUT + `//mandelsoft/test:v1...
+copied 1 from 1 artefact(s) and 1 repositories
+`))
+
+			repo, err := ctf.Open(env.OCIContext(), accessobj.ACC_READONLY, OUT, 0, env)
+			Expect(err).To(Succeed())
+			defer Close(repo)
+			art, err := repo.LookupArtefact(NS, VERSION)
+			Expect(err).To(Succeed())
+			defer Close(art)
+			Expect(art.IsManifest()).To(BeTrue())
+			Expect(art.Digest()).To(Equal(arm64.Digest))
+		})
+
+		It("transfers a reduced index", func() {
+			buf := bytes.NewBuffer(nil)
+			Expect(env.CatchOutput(buf).Execute("transfer", "artefact", "--platform", "linux/arm64", "--platform", "linux/amd64", ARCH+"//"+NS+":"+VERSION, "directory::"+OUT)).To(Succeed())
+			Expect(buf.String()).To(StringEqualTrimmedWithContext(
+				`
+copying /tmp/ctf//mandelsoft/test:v1 to directory::` + OUT + `//mandelsoft/test:v1...
+copied 1 from 1 artefact(s) and 1 repositories
+`))
+
+			repo, err := ctf.Open(env.OCIContext(), accessobj.ACC_READONLY, OUT, 0, env)
+			Expect(err).To(Succeed())
+			defer Close(repo)
+			art, err := repo.LookupArtefact(NS, VERSION)
+			Expect(err).To(Succeed())
+			defer Close(art)
+			Expect(art.IsIndex()).To(BeTrue())
+			list := art.IndexAccess().GetDescriptor().Manifests
+			Expect(len(list)).To(Equal(2))
+			Expect(list[0].Digest).To(Equal(amd64.Digest))
+			Expect(list[1].Digest).To(Equal(arm64.Digest))
+		})
+
+		It("fails for unknown platform", func() {
+			buf := bytes.NewBuffer(nil)
+			Expect(env.CatchOutput(buf).Execute("transfer", "artefact", "--platform", "linux/s390x", ARCH+"//"+NS+":"+VERSION, "directory::"+OUT)).NotTo(Succeed())
+		})
+
+		It("rejects invalid platform", func() {
+			Expect(env.Execute("transfer", "artefact", "--platform", "linux", ARCH+"//"+NS+":"+VERSION, "directory::"+OUT)).To(MatchError("platform \"linux\" is invalid"))
+		})
+	})
 })
@@ -0,0 +1,54 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package artefacthdlr
+
+import (
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/output"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/processing"
+	"github.com/open-component-model/ocm/pkg/common"
+)
+
+var ExplodeReferrers = processing.Explode(explodeReferrers)
+
+func explodeReferrers(o interface{}) []interface{} {
+	obj := o.(*Object)
+	result := []interface{}{o}
+	blob, _ := obj.Artefact.Blob()
+	dig := blob.Digest()
+	list, err := obj.Namespace.GetReferrers(dig, "")
+	hist := append(obj.History.Copy(), common.NewNameVersion("", dig.String()))
+	if err == nil {
+		for _, r := range list {
+			a, err := obj.Namespace.GetArtefact(r.Digest.String())
+			if err == nil {
+				d := r.Digest
+				s := obj.Spec
+				s.Tag = nil
+				s.Digest = &d
+				ref := &Object{
+					History:    hist,
+					Key:        Key(a),
+					Spec:       s,
+					AttachKind: r.ArtifactType,
+					Namespace:  obj.Namespace,
+					Artefact:   a,
+				}
+				result = append(result, explodeReferrers(ref)...)
+			}
+		}
+	}
+	output.Print(result, "referrers %s", dig)
+	return result
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package clean
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/out"
+)
+
+var (
+	Names = names.TransportArchive
+	Verb  = verbs.Clean
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	DryRun bool
+	Path   string
+}
+
+// NewCommand creates a new ctf cleanup command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx)}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "[<options>] <path>",
+		Args:  cobra.ExactArgs(1),
+		Short: "cleanup unused blobs of a transport archive",
+		Long: `
+Remove all blobs from a transport archive, which are not used anymore by
+any artefact listed in the archive index. Such blobs may be left over
+after overwriting tags or component versions.
+
+With option <code>--dry-run</code> the obsolete blobs are only listed
+together with the size of storage which would be reclaimed.
+`,
+		Example: `
+$ ocm clean ctf --dry-run ctf.tgz
+`,
+	}
+}
+
+func (o *Command) AddFlags(fs *pflag.FlagSet) {
+	o.BaseCommand.AddFlags(fs)
+	fs.BoolVarP(&o.DryRun, "dry-run", "", false, "only report obsolete blobs")
+}
+
+func (o *Command) Complete(args []string) error {
+	o.Path = args[0]
+	return nil
+}
+
+func (o *Command) Run() error {
+	mode := accessobj.ACC_WRITABLE
+	if o.DryRun {
+		mode = accessobj.ACC_READONLY
+	}
+	repo, err := ctf.Open(o.Context.OCIContext(), mode, o.Path, 0, o.Context.FileSystem())
+	if err != nil {
+		return errors.Wrapf(err, "cannot open transport archive %q", o.Path)
+	}
+	result, err := ctf.GC(repo, o.DryRun)
+	if err != nil {
+		repo.Close()
+		return err
+	}
+	err = repo.Close()
+	if err != nil {
+		return err
+	}
+	size := float64(result.Size) / 1024 / 1024
+	if o.DryRun {
+		for _, d := range result.Blobs {
+			out.Outf(o.Context, "%s\n", d)
+		}
+		out.Outf(o.Context, "Found %d obsolete blobs [%.2f MB]\n", len(result.Blobs), size)
+	} else {
+		out.Outf(o.Context, "Successfully deleted %d blobs [%.2f MB]\n", len(result.Blobs), size)
+	}
+	return nil
+}
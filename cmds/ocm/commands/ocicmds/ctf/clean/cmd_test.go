@@ -0,0 +1,96 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package clean_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const ARCH = "/tmp/ctf"
+const VERSION = "v1"
+const NS = "mandelsoft/test"
+
+var _ = Describe("Clean Transport Archive", func() {
+	var env *TestEnv
+	var orphan accessio.BlobAccess
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+		env.OCICommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Namespace(NS, func() {
+				env.Manifest(VERSION, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata")
+					})
+				})
+			})
+		})
+
+		orphan = accessio.BlobAccessForString(mime.MIME_TEXT, "orphan")
+		repo, err := ctf.Open(env.OCIContext(), accessobj.ACC_WRITABLE, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		ns, err := repo.LookupNamespace(NS)
+		Expect(err).To(Succeed())
+		Expect(ns.AddBlob(orphan)).To(Succeed())
+		Close(ns)
+		Close(repo)
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("lists obsolete blobs", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("clean", "ctf", "--dry-run", ARCH)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+sha256:88f6811ab5d8fc6d3177f9b7609ae0fcebfda187e5046b62d38bb539e88b74d7
+Found 1 obsolete blobs [0.00 MB]
+`))
+		Expect(env.FileExists(ARCH + "/" + ctf.BlobsDirectoryName + "/" + common.DigestToFileName(orphan.Digest()))).To(BeTrue())
+	})
+
+	It("removes obsolete blobs", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("clean", "ctf", ARCH)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+Successfully deleted 1 blobs [0.00 MB]
+`))
+		Expect(env.FileExists(ARCH + "/" + ctf.BlobsDirectoryName + "/" + common.DigestToFileName(orphan.Digest()))).To(BeFalse())
+
+		buf.Reset()
+		Expect(env.CatchOutput(buf).Execute("clean", "ctf", "--dry-run", ARCH)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+Found 0 obsolete blobs [0.00 MB]
+`))
+	})
+})
@@ -17,6 +17,7 @@ package ctf
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/ctf/clean"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/ctf/create"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/names"
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
@@ -31,5 +32,6 @@ func NewCommand(ctx clictx.Context) *cobra.Command {
 		Short: "Commands acting on OCI view of a Common Transport Archive",
 	}, Names...)
 	cmd.AddCommand(create.NewCommand(ctx, create.Verb))
+	cmd.AddCommand(clean.NewCommand(ctx, clean.Verb))
 	return cmd
 }
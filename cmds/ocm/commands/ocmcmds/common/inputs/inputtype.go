@@ -26,6 +26,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/common/accessio"
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
 	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/runtime"
 	"github.com/open-component-model/ocm/pkg/utils"
@@ -38,6 +39,24 @@ type InputSpec interface {
 	Validate(fldPath *field.Path, ctx clictx.Context, inputFilePath string) field.ErrorList
 	GetBlob(ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, error)
 }
+
+// LabeledBlobProvider is an optional interface for an input specification
+// providing additional labels for the element the input is used for
+// together with the blob.
+type LabeledBlobProvider interface {
+	GetBlobWithLabels(ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, metav1.Labels, error)
+}
+
+// GetBlobWithLabels provides the blob for an input specification together
+// with the additional labels, if it is a LabeledBlobProvider.
+func GetBlobWithLabels(spec InputSpec, ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, metav1.Labels, error) {
+	if p, ok := spec.(LabeledBlobProvider); ok {
+		return p.GetBlobWithLabels(ctx, nv, inputFilePath)
+	}
+	blob, hint, err := spec.GetBlob(ctx, nv, inputFilePath)
+	return blob, hint, nil, err
+}
+
 type InputType interface {
 	runtime.TypedObjectDecoder
 	runtime.VersionedTypedObject
@@ -230,14 +249,21 @@ func (s *GenericInputSpec) Validate(fldPath *field.Path, ctx clictx.Context, inp
 }
 
 func (s *GenericInputSpec) GetBlob(ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, error) {
+	blob, hint, _, err := s.GetBlobWithLabels(ctx, nv, inputFilePath)
+	return blob, hint, err
+}
+
+// GetBlobWithLabels provides the blob and the labels provided by the
+// effective input specification, if it is a LabeledBlobProvider.
+func (s *GenericInputSpec) GetBlobWithLabels(ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, metav1.Labels, error) {
 	if s.effective == nil {
 		var err error
 		s.effective, err = s.Evaluate(For(ctx))
 		if err != nil {
-			return nil, "", err
+			return nil, "", nil, err
 		}
 	}
-	return s.effective.GetBlob(ctx, nv, inputFilePath)
+	return GetBlobWithLabels(s.effective, ctx, nv, inputFilePath)
 }
 
 func (s *GenericInputSpec) Evaluate(scheme InputTypeScheme) (InputSpec, error) {
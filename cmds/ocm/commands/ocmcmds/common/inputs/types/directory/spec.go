@@ -25,6 +25,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/common/accessio"
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/reproducibleattr"
 	"github.com/open-component-model/ocm/pkg/mime"
 	"github.com/open-component-model/ocm/pkg/utils/tarutils"
 )
@@ -46,6 +47,10 @@ type Spec struct {
 	// This options will include the content of the symlink directly in the tar.
 	// This option should be used with care.
 	FollowSymlinks *bool `json:"followSymlinks,omitempty"`
+	// Reproducible configures to normalize file modes, ownership and timestamps,
+	// so that the same directory tree always results in the same blob.
+	// If not set, the reproducible attribute of the OCM context is used.
+	Reproducible *bool `json:"reproducible,omitempty"`
 }
 
 var _ inputs.InputSpec = (*Spec)(nil)
@@ -57,6 +62,7 @@ func New(path, mediatype string, compress bool) *Spec {
 		IncludeFiles:   nil,
 		ExcludeFiles:   nil,
 		FollowSymlinks: nil,
+		Reproducible:   nil,
 	}
 }
 
@@ -86,6 +92,10 @@ func (s *Spec) GetBlob(ctx clictx.Context, nv common.NameVersion, inputFilePath
 		ExcludeFiles:   s.ExcludeFiles,
 		PreserveDir:    s.PreserveDir != nil && *s.PreserveDir,
 		FollowSymlinks: s.FollowSymlinks != nil && *s.FollowSymlinks,
+		Reproducible:   reproducibleattr.Get(ctx.OCMContext()),
+	}
+	if s.Reproducible != nil {
+		opts.Reproducible = *s.Reproducible
 	}
 
 	temp, err := accessio.NewTempFile(fs, "", "resourceblob*.tgz")
@@ -96,6 +106,8 @@ func (s *Spec) GetBlob(ctx clictx.Context, nv common.NameVersion, inputFilePath
 
 	if s.Compress() {
 		s.SetMediaTypeIfNotDefined(mime.MIME_TGZ)
+		// the gzip header contains neither a file name nor a timestamp,
+		// so compression keeps reproducible archives stable.
 		gw := gzip.NewWriter(temp.Writer())
 		if err := tarutils.TarFileSystem(fs, inputPath, gw, opts); err != nil {
 			return nil, "", fmt.Errorf("unable to tar input artifact: %w", err)
@@ -32,6 +32,10 @@ if the <code>compress</code> field is set to <code>true</code>. If the field
 <code>preserveDir</code> is set to true the directory itself is added to the tar.
 If the field <code>followSymLinks</code> is set to <code>true</code>, symbolic
 links are not packed but their targets files or folders.
+If the field <code>reproducible</code> is set to <code>true</code>, the archive
+only depends on the file names, types and contents: entries are added in
+lexical order, file modes, ownership and timestamps are normalized and the
+gzip header does not contain a timestamp.
 With the list fields <code>includeFiles</code> and <code>excludeFiles</code> it is 
 possible to specify which files should be included or excluded. The values are
 regular expression used to match relative file paths. If no includes are specified
@@ -64,6 +68,13 @@ This blob type specification supports the following fields:
   This OPTIONAL property describes whether symbolic links should be followed or
   included as links.
 
+- **<code>reproducible</code>** *bool*
+
+  This OPTIONAL property describes whether a reproducible archive should be
+  created. Directories and executable files get the mode 0755, all other files
+  0644, the owner is root and the modification time is the Unix epoch. If not
+  specified, the global attribute <code>reproducible</code> is used.
+
 - **<code>excludeFiles</code>** *list of regex*
 
   This OPTIONAL property describes regular expressions used to match files 
@@ -0,0 +1,245 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package dockermulti
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs/types/ocilayout"
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/docker"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/transfer"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Variant describes the image for a dedicated platform.
+// It is either taken from the docker daemon or from an
+// OCI image layout.
+type Variant struct {
+	// Image is the repository path and tag of an image in the docker daemon.
+	Image string `json:"image,omitempty"`
+	// Path is the path of an OCI image layout directory.
+	Path string `json:"path,omitempty"`
+	// Tag selects the artefact of an OCI image layout.
+	Tag string `json:"tag,omitempty"`
+	// Platform overrides the platform taken from the image.
+	Platform string `json:"platform,omitempty"`
+}
+
+// UnmarshalJSON accepts a plain string as shortcut for
+// an image in the docker daemon.
+func (v *Variant) UnmarshalJSON(data []byte) error {
+	var image string
+	if err := json.Unmarshal(data, &image); err == nil {
+		*v = Variant{Image: image}
+		return nil
+	}
+	type variant Variant
+	return json.Unmarshal(data, (*variant)(v))
+}
+
+type Spec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+
+	// Variants describes the images for the different platforms.
+	Variants []Variant `json:"variants"`
+}
+
+var _ inputs.InputSpec = (*Spec)(nil)
+
+func New(variants ...Variant) *Spec {
+	return &Spec{
+		ObjectVersionedType: runtime.ObjectVersionedType{
+			Type: TYPE,
+		},
+		Variants: variants,
+	}
+}
+
+func (s *Spec) Validate(fldPath *field.Path, ctx clictx.Context, inputFilePath string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(s.Variants) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("variants"), fmt.Sprintf("variants are required for input of type %q", s.GetType())))
+	}
+	for i, v := range s.Variants {
+		vField := fldPath.Child("variants").Index(i)
+		switch {
+		case v.Image != "" && v.Path != "":
+			allErrs = append(allErrs, field.Invalid(vField, v.Image, "only one of image or path may be specified"))
+		case v.Image != "":
+			if v.Tag != "" {
+				allErrs = append(allErrs, field.Invalid(vField.Child("tag"), v.Tag, "tag only possible for OCI image layouts"))
+			}
+			if _, _, err := docker.ParseGenericRef(v.Image); err != nil {
+				allErrs = append(allErrs, field.Invalid(vField.Child("image"), v.Image, err.Error()))
+			}
+		case v.Path != "":
+			inputInfo, filePath, err := inputs.FileInfo(ctx, v.Path, inputFilePath)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(vField.Child("path"), filePath, err.Error()))
+			} else if !inputInfo.Mode().IsDir() {
+				allErrs = append(allErrs, field.Invalid(vField.Child("path"), filePath, "no directory"))
+			}
+		default:
+			allErrs = append(allErrs, field.Required(vField, "image or path is required"))
+		}
+		if v.Platform != "" {
+			if _, err := artdesc.ParsePlatform(v.Platform); err != nil {
+				allErrs = append(allErrs, field.Invalid(vField.Child("platform"), v.Platform, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
+
+func (s *Spec) GetBlob(ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, error) {
+	index := artdesc.NewIndex()
+	blob, err := artefactset.SythesizeArtefactSet(artdesc.MediaTypeImageIndex, func(set *artefactset.ArtefactSet) error {
+		for i, v := range s.Variants {
+			var desc *artdesc.Descriptor
+			var err error
+			if v.Image != "" {
+				desc, err = s.addDockerImage(ctx, nv, v, set)
+			} else {
+				desc, err = s.addLayoutImage(ctx, v, inputFilePath, set)
+			}
+			if err != nil {
+				return errors.Wrapf(err, "variant %d", i+1)
+			}
+			if v.Platform != "" {
+				desc.Platform, err = artdesc.ParsePlatform(v.Platform)
+				if err != nil {
+					return err
+				}
+			}
+			for _, e := range index.Manifests {
+				if artdesc.PlatformString(e.Platform) == artdesc.PlatformString(desc.Platform) {
+					return errors.Newf("variant %d: duplicate platform %s", i+1, artdesc.PlatformString(desc.Platform))
+				}
+			}
+			index.Manifests = append(index.Manifests, *desc)
+		}
+
+		data, err := artdesc.EncodeIndex(index)
+		if err != nil {
+			return err
+		}
+		blob := accessio.BlobAccessForData(artdesc.MediaTypeImageIndex, data)
+		err = set.AddBlob(blob)
+		if err != nil {
+			return err
+		}
+		err = set.AddArtefactDescriptor(artdesc.DefaultBlobDescriptor(blob), nv.GetVersion())
+		if err != nil {
+			return err
+		}
+		set.Annotate(artefactset.MAINARTEFACT_ANNOTATION, blob.Digest().String())
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return blob, fmt.Sprintf("%s:%s", nv.GetName(), nv.GetVersion()), nil
+}
+
+func (s *Spec) addDockerImage(ctx clictx.Context, nv common.NameVersion, v Variant, set *artefactset.ArtefactSet) (*artdesc.Descriptor, error) {
+	locator, version, err := docker.ParseGenericRef(v.Image)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := ctx.OCIContext().RepositoryForSpec(docker.NewRepositorySpec())
+	if err != nil {
+		return nil, err
+	}
+	defer repo.Close()
+	ns, err := repo.LookupNamespace(locator)
+	if err != nil {
+		return nil, err
+	}
+	defer ns.Close()
+
+	if version == "" || version == "latest" {
+		version = nv.GetVersion()
+	}
+	art, err := ns.GetArtefact(version)
+	if err != nil {
+		return nil, err
+	}
+	defer art.Close()
+	if !art.IsManifest() {
+		return nil, errors.Newf("image %s is no image manifest", v.Image)
+	}
+	err = transfer.TransferManifest(art.ManifestAccess(), set)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := art.Blob()
+	if err != nil {
+		return nil, err
+	}
+	desc := artdesc.DefaultBlobDescriptor(blob)
+	if v.Platform == "" {
+		cfg, err := art.ManifestAccess().GetBlob(art.ManifestAccess().GetDescriptor().Config.Digest)
+		if err != nil {
+			return nil, err
+		}
+		data, err := cfg.Get()
+		if err != nil {
+			return nil, err
+		}
+		desc.Platform, err = artdesc.PlatformForConfig(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return desc, nil
+}
+
+func (s *Spec) addLayoutImage(ctx clictx.Context, v Variant, inputFilePath string, set *artefactset.ArtefactSet) (*artdesc.Descriptor, error) {
+	_, inputPath, err := inputs.FileInfo(ctx, v.Path, inputFilePath)
+	if err != nil {
+		return nil, err
+	}
+	layout, err := ocilayout.OpenLayout(ctx.FileSystem(), inputPath)
+	if err != nil {
+		return nil, err
+	}
+	found, err := layout.Lookup(v.Tag)
+	if err != nil {
+		return nil, err
+	}
+	desc := *found
+	desc.Annotations = nil
+	if v.Platform == "" {
+		desc.Platform, err = layout.Platform(&desc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = layout.Transfer(&desc, set)
+	if err != nil {
+		return nil, err
+	}
+	return &desc, nil
+}
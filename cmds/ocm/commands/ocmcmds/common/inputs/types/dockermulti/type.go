@@ -0,0 +1,64 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package dockermulti
+
+import (
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+)
+
+const TYPE = "dockerMulti"
+
+func init() {
+	inputs.DefaultInputTypeScheme.Register(TYPE, inputs.NewInputType(TYPE, &Spec{}, usage))
+}
+
+const usage = `
+This input type combines several single-platform images into one
+multi-arch image. Every variant is either taken from the local docker
+daemon or from an OCI image layout. The resulting image index is packed
+as an OCI artefact set, tagged with the version of the component version.
+
+The platform of a variant is taken from the platform information found in
+the OCI image layout index or from the image config. It can be overridden
+by the <code>platform</code> field of the variant. Every platform may
+only be used once.
+
+This blob type specification supports the following fields: 
+- **<code>variants</code>** *[]variant*
+
+  This REQUIRED property describes the images for the different
+  platforms. Every variant supports the following fields:
+
+  - **<code>image</code>** *string*
+
+    The image name to import from the local docker daemon. A plain
+    string may be used as shortcut for a variant with this field, only.
+
+  - **<code>path</code>** *string*
+
+    The path of an OCI image layout directory relative to the resources
+    file.
+
+  - **<code>tag</code>** *string*
+
+    The reference name of the image in the OCI image layout. If not specified,
+    the layout must contain exactly one artefact.
+
+  - **<code>platform</code>** *string*
+
+    An OPTIONAL platform in the form <code>&lt;os>/&lt;architecture>[/&lt;variant>]</code>
+    overriding the platform found for the image.
+
+  Exactly one of the fields <code>image</code> or <code>path</code> must be given.`
@@ -0,0 +1,153 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package git
+
+import (
+	"compress/gzip"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/git"
+	"github.com/open-component-model/ocm/pkg/git/identity"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// COMMIT_LABEL is the name of the label describing the
+// commit the content has been taken from.
+const COMMIT_LABEL = "commit"
+
+type Spec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+
+	// Repository is the URL or local path of the git repository.
+	// Local repositories are read from the filesystem of the
+	// operating system.
+	Repository string `json:"repository"`
+	// Ref is the reference (branch, tag, ...) to use.
+	Ref string `json:"ref,omitempty"`
+	// Commit is the commit to use. It takes precedence over the ref.
+	Commit string `json:"commit,omitempty"`
+	// MediaType is the media type to store with the local blob.
+	MediaType string `json:"mediaType,omitempty"`
+	// CompressWithGzip defines that the blob should be automatically compressed using gzip.
+	CompressWithGzip *bool `json:"compress,omitempty"`
+	// IncludeFiles is a list of shell file name patterns that describe the files that should be included.
+	// If nothing is defined all files are included.
+	IncludeFiles []string `json:"includeFiles,omitempty"`
+	// ExcludeFiles is a list of shell file name patterns that describe the files that should be excluded from the resulting tar.
+	// Excluded files always overwrite included files.
+	ExcludeFiles []string `json:"excludeFiles,omitempty"`
+}
+
+var _ inputs.InputSpec = (*Spec)(nil)
+var _ inputs.LabeledBlobProvider = (*Spec)(nil)
+
+func New(repository, ref, commit string) *Spec {
+	return &Spec{
+		ObjectVersionedType: runtime.ObjectVersionedType{
+			Type: TYPE,
+		},
+		Repository: repository,
+		Ref:        ref,
+		Commit:     commit,
+	}
+}
+
+// Compress returns if the blob should be compressed using gzip.
+func (s *Spec) Compress() bool {
+	if s.CompressWithGzip == nil {
+		return mime.IsGZip(s.MediaType)
+	}
+	return *s.CompressWithGzip
+}
+
+func (s *Spec) Validate(fldPath *field.Path, ctx clictx.Context, inputFilePath string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if s.Repository == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("repository"), fmt.Sprintf("repository is required for input of type %q", s.GetType())))
+	}
+	if s.Ref != "" && s.Commit != "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("commit"), s.Commit, "only one of ref or commit may be specified"))
+	}
+	return allErrs
+}
+
+func (s *Spec) GetBlob(ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, error) {
+	blob, hint, _, err := s.GetBlobWithLabels(ctx, nv, inputFilePath)
+	return blob, hint, err
+}
+
+// GetBlobWithLabels provides the blob together with the commit label
+// for the commit the content has been taken from.
+func (s *Spec) GetBlobWithLabels(ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, metav1.Labels, error) {
+	repourl := s.Repository
+	if p, ok := identity.IsLocal(repourl); ok {
+		path, err := inputs.GetPath(ctx, p, inputFilePath)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		repourl = path
+	}
+	repo, err := git.Open(ctx.CredentialsContext(), repourl)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	c, err := repo.Resolve(s.Ref, s.Commit)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	opts := &git.ArchiveOptions{
+		IncludeFiles: s.IncludeFiles,
+		ExcludeFiles: s.ExcludeFiles,
+		GitIgnore:    true,
+	}
+	mediaType := s.MediaType
+	temp, err := accessio.NewTempFile(ctx.FileSystem(), "", "sourceblob*.tar")
+	if err != nil {
+		return nil, "", nil, err
+	}
+	defer temp.Close()
+
+	if s.Compress() {
+		if mediaType == "" {
+			mediaType = mime.MIME_TGZ
+		}
+		gw := gzip.NewWriter(temp.Writer())
+		if err := git.WriteTar(c, opts, gw); err != nil {
+			return nil, "", nil, fmt.Errorf("unable to tar git repository %s: %w", s.Repository, err)
+		}
+		if err := gw.Close(); err != nil {
+			return nil, "", nil, fmt.Errorf("unable to close gzip writer: %w", err)
+		}
+	} else {
+		if mediaType == "" {
+			mediaType = mime.MIME_TAR
+		}
+		if err := git.WriteTar(c, opts, temp.Writer()); err != nil {
+			return nil, "", nil, fmt.Errorf("unable to tar git repository %s: %w", s.Repository, err)
+		}
+	}
+	var labels metav1.Labels
+	labels.Set(COMMIT_LABEL, c.Hash.String())
+	return temp.AsBlob(mediaType), "", labels, nil
+}
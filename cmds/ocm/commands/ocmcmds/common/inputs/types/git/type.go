@@ -0,0 +1,83 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package git
+
+import (
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const TYPE = "git"
+
+func init() {
+	inputs.DefaultInputTypeScheme.Register(TYPE, inputs.NewInputType(TYPE, &Spec{}, usage))
+}
+
+const usage = `
+The content of a commit of a git repository is packed as a tar archive.
+Only files committed to the repository are included, files matching the
+patterns of the <code>.gitignore</code> files found in the commit are
+excluded. The archive is reproducible: the entries are written in a fixed
+order with the commit time as modification time and without owner
+information. The element is labeled with the label <code>commit</code>
+describing the used commit, if no such label is specified explicitly.
+
+This blob type specification supports the following fields: 
+- **<code>repository</code>** *string*
+
+  This REQUIRED property describes the URL of the git repository. Local
+  repositories may be given by a path relative to the resources file or
+  a <code>file://</code> URL. Credentials for remote repositories are taken
+  from the credentials context using the consumer type <code>Git</code>.
+
+  In contrast to other input types, local repositories are always read
+  from the filesystem of the operating system, even if the command uses
+  a virtual filesystem. Relative paths are only resolved correctly,
+  if the resources file is read from the filesystem of the operating system.
+
+- **<code>ref</code>** *string*
+
+  This OPTIONAL property describes the branch, tag or other reference to use.
+  If neither ref nor commit are given, <code>HEAD</code> is used.
+
+- **<code>commit</code>** *string*
+
+  This OPTIONAL property describes the commit to use.
+
+- **<code>mediaType</code>** *string*
+
+  This OPTIONAL property describes the media type to store with the local blob.
+  The default media type is ` + mime.MIME_TAR + ` and
+  ` + mime.MIME_TGZ + ` if compression is enabled.
+
+- **<code>compress</code>** *bool*
+
+  This OPTIONAL property describes whether the archive should be stored
+  compressed or not.
+
+- **<code>includeFiles</code>** *[]string*
+
+  This OPTIONAL property describes a list of shell file name patterns
+  matched against the paths in the repository, that describe the files
+  that should be included. If nothing is defined all files are included.
+  Like for the input type <code>dir</code>, a directory not matching the
+  patterns is skipped together with its content.
+
+- **<code>excludeFiles</code>** *[]string*
+
+  This OPTIONAL property describes a list of shell file name patterns
+  that describe the files that should be excluded from the resulting tar.
+  Excluded files always overwrite included files.
+`
@@ -26,12 +26,17 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/ociutils/helm"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/ociutils/helm/loader"
+	helmrepo "github.com/open-component-model/ocm/pkg/helm"
 )
 
 type Spec struct {
-	// PathSpec hold the path that points to the helm chart file
+	// PathSpec hold the path that points to the helm chart file,
+	// or the chart name, if a helm repository is given.
 	cpi.PathSpec `json:",inline"`
 	Version      string `json:"version,omitempty"`
+	// HelmRepository is the URL of a helm chart repository
+	// the chart should be taken from.
+	HelmRepository string `json:"helmRepository,omitempty"`
 }
 
 var _ inputs.InputSpec = (*Spec)(nil)
@@ -44,6 +49,12 @@ func New(path string) *Spec {
 
 func (s *Spec) Validate(fldPath *field.Path, ctx clictx.Context, inputFilePath string) field.ErrorList {
 	allErrs := s.PathSpec.Validate(fldPath, ctx, inputFilePath)
+	if s.HelmRepository != "" {
+		if s.Version == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("version"), "version is required for charts from a helm repository"))
+		}
+		return allErrs
+	}
 	if s.Path != "" {
 		path := fldPath.Child("path")
 		inputInfo, filePath, err := inputs.FileInfo(ctx, s.Path, inputFilePath)
@@ -58,6 +69,9 @@ func (s *Spec) Validate(fldPath *field.Path, ctx clictx.Context, inputFilePath s
 }
 
 func (s *Spec) GetBlob(ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, error) {
+	if s.HelmRepository != "" {
+		return s.getRepositoryBlob(ctx, nv)
+	}
 	_, inputPath, err := inputs.FileInfo(ctx, s.Path, inputFilePath)
 	if err != nil {
 		return nil, "", err
@@ -84,3 +98,11 @@ func (s *Spec) GetBlob(ctx clictx.Context, nv common.NameVersion, inputFilePath
 	}
 	return blob, hint, err
 }
+
+func (s *Spec) getRepositoryBlob(ctx clictx.Context, nv common.NameVersion) (accessio.TemporaryBlobAccess, string, error) {
+	blob, err := helmrepo.SynthesizeArtefactBlob(ctx.OCIContext(), s.HelmRepository, s.Path, s.Version)
+	if err != nil {
+		return nil, "", err
+	}
+	return blob, fmt.Sprintf("%s/%s:%s", nv.GetName(), s.Path, s.Version), nil
+}
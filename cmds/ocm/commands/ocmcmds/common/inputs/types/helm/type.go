@@ -27,6 +27,9 @@ func init() {
 const usage = `
 The path must denote an helm chart archive or directory
 relative to the resources file.
+Alternatively, a chart can be taken from a helm chart repository
+by specifying the field <code>helmRepository</code>. In this case the path
+is the name of the chart.
 The denoted chart is packed as an OCI artefact set.
 Additional provider info is taken from a file with the same name
 and the suffix <code>.prov</code>.
@@ -38,11 +41,21 @@ This blob type specification supports the following fields:
 - **<code>path</code>** *string*
 
   This REQUIRED property describes the file path to the helm chart relative to the
-  resource file location.
+  resource file location, or the chart name, if a helm repository is given.
 
 - **<code>version</code>** *string*
 
   This OPTIONAL property can be set to configure an explicit version hint.
   If not specified the versio from the chart will be used.
   Basically, it is a good practice to use the component version for local resources
-  This can be achieved by using templating for this attribute in the resource file.`
+  This can be achieved by using templating for this attribute in the resource file.
+  For charts taken from a helm repository, this property is REQUIRED and
+  describes the chart version to use.
+
+- **<code>helmRepository</code>** *string*
+
+  This OPTIONAL property describes the URL of a helm chart repository the
+  chart is downloaded from (using its <code>index.yaml</code>). Charts stored in
+  an OCI registry are addressed with the scheme <code>oci://</code>.
+  Credentials for classic helm chart repositories are taken from the
+  credentials context using the consumer type <code>HelmChartRepository</code>.`
@@ -0,0 +1,90 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ociimage
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs/cpi"
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
+)
+
+type Spec struct {
+	// PathSpec holds the OCI reference of the image
+	cpi.PathSpec `json:",inline"`
+}
+
+var _ inputs.InputSpec = (*Spec)(nil)
+
+func New(ref string) *Spec {
+	return &Spec{
+		PathSpec: cpi.NewPathSpec(TYPE, ref),
+	}
+}
+
+func (s *Spec) Validate(fldPath *field.Path, ctx clictx.Context, inputFilePath string) field.ErrorList {
+	allErrs := s.PathSpec.Validate(fldPath, ctx, inputFilePath)
+	if s.Path != "" {
+		pathField := fldPath.Child("path")
+		ref, err := oci.ParseRef(s.Path)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(pathField, s.Path, err.Error()))
+		} else if ref.IsRegistry() {
+			allErrs = append(allErrs, field.Invalid(pathField, s.Path, "repository required"))
+		}
+	}
+	return allErrs
+}
+
+func (s *Spec) GetBlob(ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, error) {
+	ref, err := oci.ParseRef(s.Path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	session := oci.NewSession(nil)
+	defer session.Close()
+
+	repo, err := session.DetermineRepositoryBySpec(ctx.OCIContext(), &ref.UniformRepositorySpec)
+	if err != nil {
+		return nil, "", err
+	}
+	ns, err := session.LookupNamespace(repo, ref.Repository)
+	if err != nil {
+		return nil, "", err
+	}
+
+	version := nv.GetVersion()
+	if ref.IsVersion() {
+		version = ref.Version()
+	}
+	blob, err := artefactset.SynthesizeArtefactBlob(ns, version)
+	if err != nil {
+		return nil, "", err
+	}
+	if ok, _ := artdesc.IsDigest(version); ok {
+		version = nv.GetVersion()
+	}
+	hint := fmt.Sprintf("%s/%s:%s", nv.GetName(), ref.Repository, version)
+	return blob, hint, nil
+}
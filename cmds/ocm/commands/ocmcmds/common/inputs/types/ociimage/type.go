@@ -0,0 +1,40 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ociimage
+
+import (
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+)
+
+const TYPE = "ociImage"
+
+func init() {
+	inputs.DefaultInputTypeScheme.Register(TYPE, inputs.NewInputType(TYPE, &Spec{}, usage))
+}
+
+const usage = `
+The path must denote an OCI image reference. The image is read from
+the denoted OCI registry (or any other OCI repository supported by the
+OCI context, like a common transport archive) and packed as an OCI
+artefact set. Image indices (multi-arch images) are stored completely,
+including all referenced manifests.
+Credentials for the registry are taken from the credentials context.
+
+This blob type specification supports the following fields: 
+- **<code>path</code>** *string*
+
+  This REQUIRED property describes the OCI image reference of the image
+  to import. If neither a tag nor a digest is given, the version of the
+  component version is used as tag.`
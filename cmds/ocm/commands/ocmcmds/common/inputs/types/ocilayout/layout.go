@@ -0,0 +1,202 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ocilayout
+
+import (
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+const (
+	// LayoutFileName is the name of the file marking an OCI image layout.
+	LayoutFileName = ociv1.ImageLayoutFile
+	// IndexFileName is the name of the index file of an OCI image layout.
+	IndexFileName = "index.json"
+	// BlobsDirectoryName is the name of the directory holding the blobs.
+	BlobsDirectoryName = "blobs"
+)
+
+// Layout provides read access to an OCI image layout directory.
+type Layout struct {
+	fs   vfs.FileSystem
+	path string
+}
+
+func OpenLayout(fs vfs.FileSystem, path string) (*Layout, error) {
+	ok, err := vfs.FileExists(fs, vfs.Join(fs, path, LayoutFileName))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.ErrInvalid("oci layout", path)
+	}
+	return &Layout{fs: fs, path: path}, nil
+}
+
+func (l *Layout) blobPath(d digest.Digest) string {
+	return vfs.Join(l.fs, l.path, BlobsDirectoryName, d.Algorithm().String(), d.Encoded())
+}
+
+func (l *Layout) GetIndex() (*artdesc.Index, error) {
+	data, err := vfs.ReadFile(l.fs, vfs.Join(l.fs, l.path, IndexFileName))
+	if err != nil {
+		return nil, err
+	}
+	return artdesc.DecodeIndex(data)
+}
+
+// Lookup determines the descriptor of the artefact tagged with the given
+// reference name. If no tag is given, the layout must contain exactly
+// one artefact.
+func (l *Layout) Lookup(tag string) (*artdesc.Descriptor, error) {
+	idx, err := l.GetIndex()
+	if err != nil {
+		return nil, err
+	}
+	if tag == "" {
+		if len(idx.Manifests) != 1 {
+			return nil, errors.Newf("oci layout %s contains %d artefacts: tag required", l.path, len(idx.Manifests))
+		}
+		return &idx.Manifests[0], nil
+	}
+	for i, m := range idx.Manifests {
+		if m.Annotations != nil && m.Annotations[ociv1.AnnotationRefName] == tag {
+			return &idx.Manifests[i], nil
+		}
+	}
+	return nil, errors.ErrNotFound(cpi.KIND_OCIARTEFACT, tag, l.path)
+}
+
+func (l *Layout) getBlob(desc *artdesc.Descriptor) (accessio.BlobAccess, error) {
+	path := l.blobPath(desc.Digest)
+	ok, err := vfs.FileExists(l.fs, path)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.ErrNotFound(cpi.KIND_BLOB, desc.Digest.String(), l.path)
+	}
+	return accessio.BlobAccessForDataAccess(desc.Digest, desc.Size, desc.MediaType, accessio.DataAccessForFile(l.fs, path)), nil
+}
+
+func (l *Layout) getArtefact(desc *artdesc.Descriptor) ([]byte, *artdesc.Artefact, error) {
+	data, err := vfs.ReadFile(l.fs, l.blobPath(desc.Digest))
+	if err != nil {
+		if vfs.IsErrNotExist(err) {
+			return nil, nil, errors.ErrNotFound(cpi.KIND_OCIARTEFACT, desc.Digest.String(), l.path)
+		}
+		return nil, nil, err
+	}
+	if d := desc.Digest.Algorithm().FromBytes(data); d != desc.Digest {
+		return nil, nil, errors.Newf("digest mismatch for artefact %s: found %s", desc.Digest, d)
+	}
+	art, err := artdesc.Decode(data)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "artefact %s", desc.Digest)
+	}
+	return data, art, nil
+}
+
+// Transfer copies the artefact described by the given descriptor with all
+// its blobs and nested artefacts to the given artefact set and adds them
+// to the index of the set. The original
+// manifests are kept, so the digests are preserved.
+func (l *Layout) Transfer(desc *artdesc.Descriptor, set *artefactset.ArtefactSet) error {
+	data, art, err := l.getArtefact(desc)
+	if err != nil {
+		return err
+	}
+	if art.IsIndex() {
+		for i := range art.Index().Manifests {
+			err = l.Transfer(&art.Index().Manifests[i], set)
+			if err != nil {
+				return errors.Wrapf(err, "indexed artefact %s", art.Index().Manifests[i].Digest)
+			}
+		}
+	} else {
+		m := art.Manifest()
+		blobs := append([]artdesc.Descriptor{m.Config}, m.Layers...)
+		for i := range blobs {
+			blob, err := l.getBlob(&blobs[i])
+			if err != nil {
+				return err
+			}
+			err = set.AddBlob(blob)
+			if err != nil {
+				return errors.Wrapf(err, "blob %s", blobs[i].Digest)
+			}
+		}
+	}
+	err = set.AddBlob(accessio.BlobAccessForData(desc.MediaType, data))
+	if err != nil {
+		return err
+	}
+	return set.AddArtefactDescriptor(desc)
+}
+
+// SynthesizeArtefactBlob creates an artefact set blob for the artefact
+// with the given tag found in the layout.
+func (l *Layout) SynthesizeArtefactBlob(tag string) (artefactset.ArtefactBlob, error) {
+	desc, err := l.Lookup(tag)
+	if err != nil {
+		return nil, err
+	}
+	return artefactset.SythesizeArtefactSet(desc.MediaType, func(set *artefactset.ArtefactSet) error {
+		err := l.Transfer(desc, set)
+		if err != nil {
+			return err
+		}
+		if tag != "" {
+			err = set.AddTags(desc.Digest, tag)
+			if err != nil {
+				return err
+			}
+		}
+		set.Annotate(artefactset.MAINARTEFACT_ANNOTATION, desc.Digest.String())
+		return nil
+	})
+}
+
+// Platform determines the platform of the image manifest described by
+// the given descriptor. It is taken from the descriptor, if present,
+// or from the image config.
+func (l *Layout) Platform(desc *artdesc.Descriptor) (*artdesc.Platform, error) {
+	if desc.Platform != nil {
+		return desc.Platform, nil
+	}
+	_, art, err := l.getArtefact(desc)
+	if err != nil {
+		return nil, err
+	}
+	if !art.IsManifest() {
+		return nil, errors.Newf("artefact %s is no image manifest", desc.Digest)
+	}
+	blob, err := l.getBlob(&art.Manifest().Config)
+	if err != nil {
+		return nil, err
+	}
+	data, err := blob.Get()
+	if err != nil {
+		return nil, err
+	}
+	return artdesc.PlatformForConfig(data)
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ocilayout
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs/cpi"
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+)
+
+type Spec struct {
+	// PathSpec holds the path of the OCI image layout directory
+	cpi.PathSpec `json:",inline"`
+	// Tag selects the artefact by its reference name.
+	Tag string `json:"tag,omitempty"`
+}
+
+var _ inputs.InputSpec = (*Spec)(nil)
+
+func New(path, tag string) *Spec {
+	return &Spec{
+		PathSpec: cpi.NewPathSpec(TYPE, path),
+		Tag:      tag,
+	}
+}
+
+func (s *Spec) Validate(fldPath *field.Path, ctx clictx.Context, inputFilePath string) field.ErrorList {
+	allErrs := s.PathSpec.Validate(fldPath, ctx, inputFilePath)
+	if s.Path != "" {
+		pathField := fldPath.Child("path")
+		inputInfo, filePath, err := inputs.FileInfo(ctx, s.Path, inputFilePath)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(pathField, filePath, err.Error()))
+		} else if !inputInfo.Mode().IsDir() {
+			allErrs = append(allErrs, field.Invalid(pathField, filePath, "no directory"))
+		}
+	}
+	return allErrs
+}
+
+func (s *Spec) GetBlob(ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, error) {
+	_, inputPath, err := inputs.FileInfo(ctx, s.Path, inputFilePath)
+	if err != nil {
+		return nil, "", err
+	}
+	layout, err := OpenLayout(ctx.FileSystem(), inputPath)
+	if err != nil {
+		return nil, "", err
+	}
+	blob, err := layout.SynthesizeArtefactBlob(s.Tag)
+	if err != nil {
+		return nil, "", err
+	}
+	version := s.Tag
+	if version == "" {
+		version = nv.GetVersion()
+	}
+	return blob, fmt.Sprintf("%s:%s", nv.GetName(), version), nil
+}
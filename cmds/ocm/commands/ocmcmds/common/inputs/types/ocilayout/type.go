@@ -0,0 +1,45 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ocilayout
+
+import (
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+)
+
+const TYPE = "ociLayout"
+
+func init() {
+	inputs.DefaultInputTypeScheme.Register(TYPE, inputs.NewInputType(TYPE, &Spec{}, usage))
+}
+
+const usage = `
+The path must denote a directory containing an OCI image layout
+relative to the resources file. The selected artefact is packed as
+an OCI artefact set. Image indices (multi-arch images) are stored
+completely, including all referenced manifests. The manifests are kept
+as found in the layout, so their digests are preserved.
+
+This blob type specification supports the following fields: 
+- **<code>path</code>** *string*
+
+  This REQUIRED property describes the path of the OCI image layout
+  directory relative to the resources file.
+
+- **<code>tag</code>** *string*
+
+  This OPTIONAL property selects the artefact by its reference name
+  (annotation <code>org.opencontainers.image.ref.name</code>) in the
+  index of the layout. If not specified, the layout must contain exactly
+  one artefact.`
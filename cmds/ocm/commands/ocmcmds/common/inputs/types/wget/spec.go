@@ -0,0 +1,172 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package wget
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	hd "github.com/open-component-model/ocm/pkg/common/accessio/downloader/http"
+	"github.com/open-component-model/ocm/pkg/common/compression"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	httpaccess "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/http"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/http/identity"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+type Spec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+
+	// URL is the HTTP(S) URL of the content to download.
+	URL string `json:"url"`
+	// Digest is the expected digest of the downloaded content (<algorithm>:<hex>).
+	Digest string `json:"digest"`
+	// MediaType is the media type to store with the local blob.
+	MediaType string `json:"mediaType,omitempty"`
+	// Decompress defines whether the downloaded content should be decompressed.
+	Decompress *bool `json:"decompress,omitempty"`
+}
+
+var _ inputs.InputSpec = (*Spec)(nil)
+
+func New(url, digest, mediaType string, decompress bool) *Spec {
+	return &Spec{
+		ObjectVersionedType: runtime.ObjectVersionedType{
+			Type: TYPE,
+		},
+		URL:        url,
+		Digest:     digest,
+		MediaType:  mediaType,
+		Decompress: &decompress,
+	}
+}
+
+func (s *Spec) Validate(fldPath *field.Path, ctx clictx.Context, inputFilePath string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if s.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), fmt.Sprintf("url is required for input of type %q", s.GetType())))
+	} else {
+		u, err := url.Parse(s.URL)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), s.URL, err.Error()))
+		} else if u.Scheme != "http" && u.Scheme != "https" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), s.URL, "http or https url required"))
+		}
+	}
+	if s.Digest == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("digest"), fmt.Sprintf("digest is required for input of type %q", s.GetType())))
+	} else {
+		d, err := digest.Parse(s.Digest)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("digest"), s.Digest, err.Error()))
+		} else if d.Algorithm() != digest.SHA256 && d.Algorithm() != digest.SHA512 {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("digest"), d.Algorithm().String(), []string{digest.SHA256.String(), digest.SHA512.String()}))
+		}
+	}
+	return allErrs
+}
+
+func (s *Spec) GetBlob(ctx clictx.Context, nv common.NameVersion, inputFilePath string) (accessio.TemporaryBlobAccess, string, error) {
+	fs := ctx.FileSystem()
+	creds, err := identity.GetCredentials(ctx.CredentialsContext(), s.URL)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "failed to get credentials for %s", s.URL)
+	}
+
+	mediaType := s.MediaType
+	if mediaType == "" {
+		mediaType = mime.MIME_OCTET
+	}
+	temp, err := vfs.TempFile(fs, "", "download*")
+	if err != nil {
+		return nil, "", err
+	}
+	blob := accessio.TempFileBlobAccess(mediaType, fs, temp)
+	defer func() {
+		if blob != nil {
+			blob.Close()
+		}
+	}()
+
+	err = hd.NewDownloader(s.URL, httpaccess.DownloaderOptions(creds)...).Download(temp)
+	if err != nil {
+		return nil, "", err
+	}
+	err = s.verify(blob)
+	if err != nil {
+		return nil, "", err
+	}
+	if s.Decompress != nil && *s.Decompress {
+		result, err := s.decompress(fs, blob, mediaType)
+		if err != nil {
+			return nil, "", err
+		}
+		return result, "", nil
+	}
+	result := blob
+	blob = nil
+	return result, "", nil
+}
+
+// verify checks the downloaded content against the declared digest.
+func (s *Spec) verify(blob accessio.BlobAccess) error {
+	expected := digest.Digest(s.Digest)
+	r, err := blob.Reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	d, err := expected.Algorithm().FromReader(r)
+	if err != nil {
+		return err
+	}
+	if d != expected {
+		return errors.Newf("digest mismatch for %s: expected %s, found %s", s.URL, expected, d)
+	}
+	return nil
+}
+
+func (s *Spec) decompress(fs vfs.FileSystem, blob accessio.BlobAccess, mediaType string) (accessio.TemporaryBlobAccess, error) {
+	r, err := blob.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	reader, _, err := compression.AutoDecompress(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot decompress %s", s.URL)
+	}
+	defer reader.Close()
+
+	temp, err := accessio.NewTempFile(fs, "", "decompressed*")
+	if err != nil {
+		return nil, err
+	}
+	defer temp.Close()
+	if _, err := io.Copy(temp.Writer(), reader); err != nil {
+		return nil, errors.Wrapf(err, "cannot decompress %s", s.URL)
+	}
+	return temp.AsBlob(mediaType), nil
+}
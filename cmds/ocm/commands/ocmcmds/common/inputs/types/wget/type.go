@@ -0,0 +1,56 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package wget
+
+import (
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const TYPE = "wget"
+
+func init() {
+	inputs.DefaultInputTypeScheme.Register(TYPE, inputs.NewInputType(TYPE, &Spec{}, usage))
+}
+
+const usage = `
+The content is downloaded from the given HTTP(S) URL and verified
+against the declared digest. Credentials are taken from the credentials
+context using the consumer type <code>HTTP</code> with the hostname,
+port and path prefix of the URL.
+
+This blob type specification supports the following fields: 
+- **<code>url</code>** *string*
+
+  This REQUIRED property describes the HTTP(S) URL of the content to download.
+
+- **<code>digest</code>** *string*
+
+  This REQUIRED property describes the expected digest of the downloaded
+  content in the form <code>&lt;algorithm>:&lt;hex></code>. Supported
+  algorithms are <code>sha256</code> and <code>sha512</code>.
+
+- **<code>mediaType</code>** *string*
+
+  This OPTIONAL property describes the media type to store with the local blob.
+  The default media type is ` + mime.MIME_OCTET + `.
+
+- **<code>decompress</code>** *bool*
+
+  This OPTIONAL property describes whether the downloaded content should be
+  decompressed before it is stored. The compression format (gzip, bzip2, xz
+  or zstd) is detected automatically. The digest is always verified for the
+  downloaded content.
+`
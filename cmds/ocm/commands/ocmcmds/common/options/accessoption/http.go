@@ -0,0 +1,62 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package accessoption
+
+import (
+	"github.com/opencontainers/go-digest"
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/http"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+func init() {
+	RegisterBuilder(http.Type, func() Builder { return &httpBuilder{} })
+}
+
+type httpBuilder struct {
+	URL       string
+	MediaType string
+	Digest    string
+}
+
+func (b *httpBuilder) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&b.URL, "url", "", "", "URL of blob (access type "+http.Type+")")
+	fs.StringVarP(&b.MediaType, "mediaType", "", "", "media type of blob (access type "+http.Type+")")
+	fs.StringVarP(&b.Digest, "digest", "", "", "expected digest of blob (access type "+http.Type+")")
+}
+
+func (b *httpBuilder) Build() (ocm.AccessSpec, error) {
+	if b.URL == "" {
+		return nil, errors.Newf("option --url required for access type %s", http.Type)
+	}
+	if b.Digest != "" {
+		if _, err := digest.Parse(b.Digest); err != nil {
+			return nil, errors.ErrInvalidWrap(err, "digest", b.Digest)
+		}
+	}
+	return http.New(b.URL, b.MediaType, b.Digest), nil
+}
+
+func (b *httpBuilder) Usage() string {
+	return `
+  The blob is downloaded from the URL given by option <code>--url</code>.
+  Option <code>--mediaType</code> describes the media type of the blob and
+  option <code>--digest</code> the expected digest
+  (<code>&lt;algorithm>:&lt;hex></code>). If a digest is given, the access
+  fails if the downloaded content does not match.
+`
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package accessoption
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/options"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// Builder creates an access specification for an access type
+// from dedicated command line options.
+type Builder interface {
+	AddFlags(fs *pflag.FlagSet)
+	Build() (ocm.AccessSpec, error)
+	Usage() string
+}
+
+var (
+	lock     sync.RWMutex
+	builders = map[string]func() Builder{}
+)
+
+// RegisterBuilder registers a command line builder for an access type.
+func RegisterBuilder(atype string, creator func() Builder) {
+	lock.Lock()
+	defer lock.Unlock()
+	builders[atype] = creator
+}
+
+func From(o options.OptionSetProvider) *Option {
+	var opt *Option
+	o.AsOptionSet().Get(&opt)
+	return opt
+}
+
+func New() *Option {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	o := &Option{builders: map[string]Builder{}}
+	for t, c := range builders {
+		o.builders[t] = c()
+	}
+	return o
+}
+
+type Option struct {
+	AccessType string
+	builders   map[string]Builder
+}
+
+var _ options.SimpleOptionCompleter = (*Option)(nil)
+
+func (o *Option) types() []string {
+	list := []string{}
+	for t := range o.builders {
+		list = append(list, t)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func (o *Option) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.AccessType, "accessType", "", "", "type of access specification given by options ("+strings.Join(o.types(), ", ")+")")
+	for _, t := range o.types() {
+		o.builders[t].AddFlags(fs)
+	}
+}
+
+func (o *Option) Complete() error {
+	if o.AccessType != "" && o.builders[o.AccessType] == nil {
+		return errors.ErrUnknown(errors.KIND_ACCESSMETHOD, o.AccessType)
+	}
+	return nil
+}
+
+// Build provides the access specification described by the options.
+// If no access type is given, nil is returned.
+func (o *Option) Build() (ocm.AccessSpec, error) {
+	if o.AccessType == "" {
+		return nil, nil
+	}
+	b := o.builders[o.AccessType]
+	if b == nil {
+		return nil, errors.ErrUnknown(errors.KIND_ACCESSMETHOD, o.AccessType)
+	}
+	return b.Build()
+}
+
+func (o *Option) Usage() string {
+	s := `
+With option <code>--accessType</code> an external resource can be added
+without a resource specification file. The resource is described by the
+options <code>--name</code>, <code>--type</code> and <code>--version</code>,
+and the access specification by the options of the selected access type:
+`
+	for _, t := range o.types() {
+		s += "\n- <code>" + t + "</code>:\n" + o.builders[t].Usage()
+	}
+	return s
+}
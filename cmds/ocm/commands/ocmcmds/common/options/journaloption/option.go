@@ -0,0 +1,112 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package journaloption
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/options"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/tmpcache"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+)
+
+func From(o options.OptionSetProvider) *Option {
+	var opt *Option
+	o.AsOptionSet().Get(&opt)
+	return opt
+}
+
+func New() *Option {
+	return &Option{}
+}
+
+type Option struct {
+	Path    string
+	Resume  bool
+	Journal *journal.Journal
+
+	temporary bool
+}
+
+var _ transferhandler.TransferOption = (*Option)(nil)
+
+func (o *Option) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Path, "journal", "", "", "file used to record the transfer progress")
+	fs.BoolVarP(&o.Resume, "resume", "", false, "resume an interrupted transfer")
+}
+
+// Open provides the journal for a transfer into the given target repository.
+// Without an explicit journal file, a journal in the folder of
+// the temporary blob cache is used, which is specific for the target.
+func (o *Option) Open(ctx clictx.Context, target ocm.Repository) error {
+	var fs vfs.FileSystem
+
+	path := o.Path
+	if path == "" {
+		data, err := json.Marshal(target.GetSpecification())
+		if err != nil {
+			return err
+		}
+		cache := tmpcache.Get(ctx.OCMContext())
+		fs = cache.Filesystem
+		path = vfs.Join(fs, cache.Path, fmt.Sprintf("ocm-transfer-%s.json", digest.FromBytes(data).Encoded()[:16]))
+		o.temporary = true
+	} else {
+		fs = ctx.FileSystem()
+	}
+	j, err := journal.Open(fs, path, o.Resume)
+	if err != nil {
+		return err
+	}
+	o.Journal = j
+	return nil
+}
+
+// Finish is called after a successful transfer.
+// It removes a temporary journal, which is kept
+// for failed transfers to be resumed.
+func (o *Option) Finish() error {
+	if o.temporary {
+		return o.Journal.Remove()
+	}
+	return nil
+}
+
+func (o *Option) Usage() string {
+	s := `
+The progress of a transfer is recorded in a transfer journal. If a transfer
+fails, it can be continued with the option <code>--resume</code>. Already
+transferred component versions are skipped then, and blobs already stored
+in the target are reused after checking their digests, instead of transferring
+them again. By default, the journal is kept in the folder of the temporary blob
+cache and removed after a successful transfer. With the option
+<code>--journal</code> an explicit journal file can be specified, which is
+kept for subsequent incremental transfers.
+`
+	return s
+}
+
+func (o *Option) ApplyTransferOption(opts transferhandler.TransferOptions) error {
+	return standard.Journal(o.Journal).ApplyTransferOption(opts)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verifyattr"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/normalizations/jsonv1"
 	ocmsign "github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
@@ -49,6 +50,7 @@ func New(sign bool) *Option {
 
 type Option struct {
 	rootca []string
+	tsaca  []string
 
 	local         bool
 	SignMode      bool
@@ -57,7 +59,9 @@ type Option struct {
 	publicKeys    []string
 	privateKeys   []string
 	Issuer        string
+	TSAUrl        string
 	RootCerts     *x509.CertPool
+	TSARootCerts  *x509.CertPool
 	// Verify the digests
 	Verify bool
 
@@ -82,6 +86,7 @@ func (o *Option) AddFlags(fs *pflag.FlagSet) {
 		fs.StringVarP(&o.NormAlgorithm, "normalization", "N", jsonv1.Algorithm, "normalization algorithm")
 		fs.StringVarP(&o.hashAlgorithm, "hash", "H", sha256.Algorithm, "hash algorithm")
 		fs.StringVarP(&o.Issuer, "issuer", "I", "", "issuer name")
+		fs.StringVarP(&o.TSAUrl, "tsa", "", "", "URL of RFC 3161 timestamp authority")
 		fs.BoolVarP(&o.Update, "update", "", o.SignMode, "update digest in component versions")
 		fs.BoolVarP(&o.Recursively, "recursive", "R", false, "recursively sign component versions")
 	} else {
@@ -89,6 +94,7 @@ func (o *Option) AddFlags(fs *pflag.FlagSet) {
 	}
 	fs.BoolVarP(&o.Verify, "verify", "V", o.SignMode, "verify existing digests")
 	fs.StringArrayVarP(&o.rootca, "ca-cert", "", o.rootca, "Additional root certificates")
+	fs.StringArrayVarP(&o.tsaca, "tsa-ca-cert", "", o.tsaca, "root certificates of trusted timestamp authorities")
 }
 
 func (o *Option) Complete(ctx clictx.Context) error {
@@ -132,50 +138,76 @@ func (o *Option) Complete(ctx clictx.Context) error {
 		o.Recursively = !o.local
 	}
 
-	err := o.handleKeys(ctx, "public key", o.publicKeys, o.Keys.RegisterPublicKey)
+	err := o.handleKeys(ctx, "public key", false, o.publicKeys, o.Keys.RegisterPublicKey)
 	if err != nil {
 		return err
 	}
-	err = o.handleKeys(ctx, "private key", o.privateKeys, o.Keys.RegisterPrivateKey)
+	err = o.handleKeys(ctx, "private key", true, o.privateKeys, o.Keys.RegisterPrivateKey)
 	if err != nil {
 		return err
 	}
 
-	if len(o.rootca) > 0 {
-		pool, err := signing.BaseRootPool()
+	o.RootCerts, err = o.rootPool(ctx, o.rootca)
+	if err != nil {
+		return err
+	}
+	o.TSARootCerts, err = o.rootPool(ctx, o.tsaca)
+	return err
+}
+
+func (o *Option) rootPool(ctx clictx.Context, files []string) (*x509.CertPool, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+	pool, err := signing.BaseRootPool()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range files {
+		data, err := vfs.ReadFile(ctx.FileSystem(), r)
 		if err != nil {
-			return err
+			return nil, errors.Wrapf(err, "cannot read ca file %q", r)
 		}
-		for _, r := range o.rootca {
-			data, err := vfs.ReadFile(ctx.FileSystem(), r)
-			if err != nil {
-				return errors.Wrapf(err, "cannot read ca file %q", r)
-			}
-			ok := pool.AppendCertsFromPEM(data)
-			if !ok {
-				return errors.Newf("cannot add rot certs from %q", r)
-			}
+		ok := pool.AppendCertsFromPEM(data)
+		if !ok {
+			return nil, errors.Newf("cannot add root certs from %q", r)
 		}
-		o.RootCerts = pool
 	}
-	return nil
+	return pool, nil
 }
 
-func (o *Option) handleKeys(ctx clictx.Context, desc string, keys []string, add func(string, interface{})) error {
-	for i, k := range keys {
+func (o *Option) handleKeys(ctx clictx.Context, desc string, private bool, keys []string, add func(string, interface{})) error {
+	for _, k := range keys {
 		name := ""
 		if len(o.SignatureNames) > 0 {
 			name = o.SignatureNames[0]
 		}
 		file := k
-		sep := strings.Index(k, "=")
-		if sep >= 0 {
-			name = k[:sep]
-			file = k[i+1:]
+		if !signing.DefaultKeyProviderRegistry().IsKeyReference(k) {
+			sep := strings.Index(k, "=")
+			if sep >= 0 {
+				name = k[:sep]
+				file = k[sep+1:]
+			}
 		}
 		if len(file) == 0 {
 			return errors.Newf("empty file name")
 		}
+		if signing.DefaultKeyProviderRegistry().IsKeyReference(file) {
+			if name == "" {
+				return errors.Newf("signature name required")
+			}
+			signer, err := signing.DefaultKeyProviderRegistry().ResolveKeyReference(file)
+			if err != nil {
+				return errors.Wrapf(err, "cannot resolve %s %q", desc, file)
+			}
+			if private {
+				add(name, signer)
+			} else {
+				add(name, signer.Public())
+			}
+			continue
+		}
 		var data []byte
 		var err error
 		switch file[0] {
@@ -208,11 +240,33 @@ signature name specified with the option <code>--signature</code>.
 Alternatively a key can be specified as base64 encoded string if the argument
 start with the prefix <code>!</code> or as direct string with the prefix
 <code>=</code>.
+
+Keys kept in external key stores can be specified by a key reference
+of the form <code>&lt;scheme>:&lt;spec></code> instead of a file path.
+Such keys are used for signing without exporting the key material.
+
+A <code>pkcs11</code> key reference is a PKCS#11 URI (RFC 7512), for example
+<code>pkcs11:token=ocm;object=signing-key?module-path=/usr/lib/softhsm/libsofthsm2.so&pin-value=1234</code>.
+An <code>exec</code> key reference has the form <code>exec:&lt;command> {&lt;arg>}</code>.
+The command is called with the additional argument <code>public-key</code> to
+provide the PEM encoded public key or certificate, and with the additional
+arguments <code>sign &lt;hash></code> to sign the digest read from stdin.
+The raw signature has to be written to stdout.
+
+For <code>sigstore</code> signatures the private key file may additionally
+contain the PEM encoded certificate chain for the key, which is embedded into
+the signature bundle. For verification, a public key or a set of PEM encoded
+root certificates can be given. The latter are used as local trust root
+to validate the certificate chain found in the bundle.
 `
 	if o.SignMode {
 		s += `
 If in signing mode a public key is specified, existing signatures for the
 given signature name will be verified, instead of recreated.
+
+With option <code>--tsa</code> an RFC 3161 timestamp token is requested
+for the signature from the given timestamp authority and stored along with
+the signature.
 `
 		s += `
 
@@ -230,6 +284,23 @@ The following hash modes are supported with option <code>--hash</code>:
 ` + utils.FormatList(sha256.Algorithm, signing.DefaultRegistry().HasherNames()...)
 
 		signing.DefaultRegistry().HasherNames()
+	} else {
+		s += `
+If a signature carries an RFC 3161 timestamp token, the token is verified
+against the root certificates of trusted timestamp authorities given by option
+<code>--tsa-ca-cert</code> (by default the system root certificates). The token
+must be signed by a certificate restricted to timestamping. Signing
+certificates given as public key, which are expired meanwhile, are accepted
+if they were valid at the time stated by the timestamp.
+`
+		s += `
+A verification policy configured with the config type
+<code>` + verifyattr.ConfigType + `</code> is enforced for the verification.
+It may require dedicated signatures, trusted issuers and a minimum number of
+signatures, and the recursive verification of referenced component versions.
+Trusted issuers are only taken from certificates verified against the root
+certificates, the issuer claimed by a signature is not trusted.
+`
 	}
 	return s
 }
@@ -252,6 +323,12 @@ func (o *Option) ApplySigningOption(opts *ocmsign.Options) {
 	if o.RootCerts != nil {
 		opts.RootCerts = o.RootCerts
 	}
+	if o.TSARootCerts != nil {
+		opts.TSARootCerts = o.TSARootCerts
+	}
+	if o.TSAUrl != "" {
+		opts.TSAUrl = o.TSAUrl
+	}
 	if len(o.SignatureNames) > 0 {
 		opts.VerifySignature = o.Keys.GetPublicKey(o.SignatureNames[0]) != nil
 	}
@@ -36,6 +36,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/comparch"
 	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/out"
@@ -63,6 +64,10 @@ type Resource interface {
 	Source() string
 	Spec() ResourceSpec
 	Input() *ResourceInput
+	// Labels returns the given labels of the element extended by
+	// the labels provided by the input. Explicitly specified labels
+	// take precedence.
+	Labels(labels metav1.Labels) metav1.Labels
 }
 
 type resource struct {
@@ -70,6 +75,7 @@ type resource struct {
 	source string
 	spec   ResourceSpec
 	input  *ResourceInput
+	labels metav1.Labels
 }
 
 func (r *resource) Source() string {
@@ -84,6 +90,16 @@ func (r *resource) Input() *ResourceInput {
 	return r.input
 }
 
+func (r *resource) Labels(labels metav1.Labels) metav1.Labels {
+	result := labels.Copy()
+	for _, l := range r.labels {
+		if _, ok := result.Get(l.Name); !ok {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
 func NewResource(spec ResourceSpec, input *ResourceInput, path string, indices ...int) *resource {
 	id := path
 	for _, i := range indices {
@@ -104,9 +120,14 @@ type ResourceAdderCommand struct {
 	Paths      []string
 	Envs       []string
 	Templating template.Options
+
+	// Specs are additional specifications given
+	// by command line options.
+	Specs [][]byte
 }
 
 func (o *ResourceAdderCommand) AddFlags(fs *pflag.FlagSet) {
+	o.BaseCommand.AddFlags(fs)
 	fs.StringArrayVarP(&o.Envs, "settings", "s", nil, "settings file with variable settings (yaml)")
 	o.Templating.AddFlags(fs)
 }
@@ -139,81 +160,19 @@ func (o *ResourceAdderCommand) ProcessResourceDescriptions(listkey string, h Res
 		if err != nil {
 			return errors.Wrapf(err, "error during variable substitution for %q", filePath)
 		}
-		// sigs parser has no multi document stream parsing
-		// but yaml.v3 does not recognize json tagged fields.
-		// Therefore we first use the v3 parser to parse the multi doc,
-		// marshal it again and finally unmarshal it with the sigs parser.
-		decoder := yaml.NewDecoder(bytes.NewBuffer([]byte(parsed)))
-		i := 0
-		for {
-			var tmp map[string]interface{}
-
-			i++
-			err := decoder.Decode(&tmp)
-			if err != nil {
-				if !errors.Is(err, io.EOF) {
-					return err
-				}
-				break
-			}
-			out.Outf(o.Context, "  processing document %d...\n", i)
-			if (tmp["input"] != nil || tmp["access"] != nil) && !h.RequireInputs() {
-				return errors.Newf("invalid spec %d in %q: no input or access possible for %s", i, filePath, listkey)
-			}
-
-			var list []json.RawMessage
-			if reslist, ok := tmp[listkey]; ok {
-				if len(tmp) != 1 {
-					return errors.Newf("invalid spec %d in %q: either a list or a single spec possible", i, filePath)
-				}
-				l, ok := reslist.([]interface{})
-				if !ok {
-					return errors.Newf("invalid spec %d in %q: invalid resource list", i, filePath)
-				}
-				for j, e := range l {
-					// cannot use json here, because yaml generates a map[interface{}]interface{}
-					data, err = yaml.Marshal(e)
-					if err != nil {
-						return errors.Newf("invalid spec %d[%d] in %q: %s", i, j+1, filePath, err.Error())
-					}
-					list = append(list, data)
-				}
-			} else {
-				if len(tmp) == 0 {
-					return errors.Newf("invalid spec %d in %q: empty", i, filePath)
-				}
-				data, err := yaml.Marshal(tmp)
-				if err != nil {
-					return err
-				}
-				list = append(list, data)
-			}
-
-			for j, d := range list {
-				out.Outf(o.Context, "    processing index %d\n", j+1)
-				var input *ResourceInput
-				r, err := DecodeResource(d, h)
-				if err != nil {
-					return errors.Newf("invalid spec %d[%d] in %q: %s", i, j+1, filePath, err)
-				}
-
-				if h.RequireInputs() {
-					input, err = DecodeInput(d, o.Context)
-					if err != nil {
-						return errors.Newf("invalid spec %d[%d] in %q: %s", i+1, j+1, filePath, err)
-					}
-					if err = Validate(input, o.Context, filePath); err != nil {
-						return errors.Wrapf(err, "invalid spec %d[%d] in %q", i+1, j+1, filePath)
-					}
-				}
-
-				if err = r.Validate(o.Context, input); err != nil {
-					return errors.Wrapf(err, "invalid spec %d[%d] in %q", i+1, j+1, filePath)
-				}
-
-				resources = append(resources, NewResource(r, input, filePath, i, j))
-			}
+		list, err := o.parseDescriptions(listkey, h, filePath, []byte(parsed))
+		if err != nil {
+			return err
 		}
+		resources = append(resources, list...)
+	}
+	for _, data := range o.Specs {
+		out.Outf(o.Context, "processing command line options...\n")
+		list, err := o.parseDescriptions(listkey, h, "", data)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, list...)
 	}
 
 	out.Outf(o.Context, "found %d %s\n", len(resources), listkey)
@@ -229,10 +188,11 @@ func (o *ResourceAdderCommand) ProcessResourceDescriptions(listkey string, h Res
 			if r.input.Input != nil {
 				var acc ocm.AccessSpec
 				// Local Blob
-				blob, hint, berr := r.input.Input.GetBlob(o.Context, common.VersionedElementKey(obj), r.path)
+				blob, hint, labels, berr := r.input.Input.GetBlobWithLabels(o.Context, common.VersionedElementKey(obj), r.path)
 				if berr != nil {
 					return errors.Wrapf(berr, "cannot get resource blob for %q(%s)", r.spec.GetName(), r.source)
 				}
+				r.labels = labels
 				acc, err = obj.AddBlob(blob, hint, nil)
 				if err == nil {
 					err = h.Set(obj, r, acc)
@@ -251,6 +211,86 @@ func (o *ResourceAdderCommand) ProcessResourceDescriptions(listkey string, h Res
 	return nil
 }
 
+func (o *ResourceAdderCommand) parseDescriptions(listkey string, h ResourceSpecHandler, filePath string, parsed []byte) ([]*resource, error) {
+	resources := []*resource{}
+	// sigs parser has no multi document stream parsing
+	// but yaml.v3 does not recognize json tagged fields.
+	// Therefore we first use the v3 parser to parse the multi doc,
+	// marshal it again and finally unmarshal it with the sigs parser.
+	decoder := yaml.NewDecoder(bytes.NewBuffer(parsed))
+	i := 0
+	for {
+		var tmp map[string]interface{}
+
+		i++
+		err := decoder.Decode(&tmp)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				return nil, err
+			}
+			break
+		}
+		out.Outf(o.Context, "  processing document %d...\n", i)
+		if (tmp["input"] != nil || tmp["access"] != nil) && !h.RequireInputs() {
+			return nil, errors.Newf("invalid spec %d in %q: no input or access possible for %s", i, filePath, listkey)
+		}
+
+		var list []json.RawMessage
+		if reslist, ok := tmp[listkey]; ok {
+			if len(tmp) != 1 {
+				return nil, errors.Newf("invalid spec %d in %q: either a list or a single spec possible", i, filePath)
+			}
+			l, ok := reslist.([]interface{})
+			if !ok {
+				return nil, errors.Newf("invalid spec %d in %q: invalid resource list", i, filePath)
+			}
+			for j, e := range l {
+				// cannot use json here, because yaml generates a map[interface{}]interface{}
+				data, err := yaml.Marshal(e)
+				if err != nil {
+					return nil, errors.Newf("invalid spec %d[%d] in %q: %s", i, j+1, filePath, err.Error())
+				}
+				list = append(list, data)
+			}
+		} else {
+			if len(tmp) == 0 {
+				return nil, errors.Newf("invalid spec %d in %q: empty", i, filePath)
+			}
+			data, err := yaml.Marshal(tmp)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, data)
+		}
+
+		for j, d := range list {
+			out.Outf(o.Context, "    processing index %d\n", j+1)
+			var input *ResourceInput
+			r, err := DecodeResource(d, h)
+			if err != nil {
+				return nil, errors.Newf("invalid spec %d[%d] in %q: %s", i, j+1, filePath, err)
+			}
+
+			if h.RequireInputs() {
+				input, err = DecodeInput(d, o.Context)
+				if err != nil {
+					return nil, errors.Newf("invalid spec %d[%d] in %q: %s", i+1, j+1, filePath, err)
+				}
+				if err = Validate(input, o.Context, filePath); err != nil {
+					return nil, errors.Wrapf(err, "invalid spec %d[%d] in %q", i+1, j+1, filePath)
+				}
+			}
+
+			if err = r.Validate(o.Context, input); err != nil {
+				return nil, errors.Wrapf(err, "invalid spec %d[%d] in %q", i+1, j+1, filePath)
+			}
+
+			resources = append(resources, NewResource(r, input, filePath, i, j))
+		}
+	}
+	return resources, nil
+}
+
 func DecodeResource(data []byte, h ResourceSpecHandler) (ResourceSpec, error) {
 	result, err := h.Decode(data)
 	if err != nil {
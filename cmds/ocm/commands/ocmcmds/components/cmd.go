@@ -17,9 +17,12 @@ package components
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/components/delete"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/components/diff"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/components/download"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/components/get"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/components/sign"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/components/validate"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/components/verify"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/names"
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
@@ -41,5 +44,8 @@ func AddCommands(ctx clictx.Context, cmd *cobra.Command) {
 	cmd.AddCommand(get.NewCommand(ctx, get.Verb))
 	cmd.AddCommand(sign.NewCommand(ctx, sign.Verb))
 	cmd.AddCommand(verify.NewCommand(ctx, verify.Verb))
+	cmd.AddCommand(diff.NewCommand(ctx, diff.Verb))
+	cmd.AddCommand(validate.NewCommand(ctx, validate.Verb))
 	cmd.AddCommand(download.NewCommand(ctx, download.Verb))
+	cmd.AddCommand(delete.NewCommand(ctx, delete.Verb))
 }
@@ -0,0 +1,155 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package delete
+
+import (
+	"github.com/spf13/cobra"
+
+	ocmcommon "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/handlers/comphdlr"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/repooption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/output"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/out"
+)
+
+var (
+	Names = names.Components
+	Verb  = verbs.Delete
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	Refs []string
+}
+
+// NewCommand creates a new delete command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx,
+		repooption.New(),
+	)}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "[<options>] {<component-reference>}",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "delete ocm component versions",
+		Long: `
+Delete component versions from an OCM repository. The component versions must
+be specified with their version, a component name alone is not sufficient.
+
+For OCI based repositories, like a Common Transport Archive, local blobs not
+used anymore by other component versions are removed, also.
+
+Repositories not supporting the deletion of component versions reject it.
+For example, component archives always contain exactly one component version.
+Such an archive is deleted by just removing it.
+`,
+		Example: `
+$ ocm delete componentversion --repo ctf.tgz github.com/mandelsoft/kubelink:v1.0.0
+`,
+	}
+}
+
+func (o *Command) Complete(args []string) error {
+	o.Refs = args
+	for _, r := range args {
+		var err error
+		versioned := false
+		if repooption.From(o).Spec != "" {
+			var spec ocm.CompSpec
+			spec, err = ocm.ParseComp(r)
+			versioned = spec.IsVersion()
+		} else {
+			var spec ocm.RefSpec
+			spec, err = ocm.ParseRef(r)
+			versioned = spec.IsVersion()
+		}
+		if err != nil {
+			return errors.Wrapf(err, "reference %q", r)
+		}
+		if !versioned {
+			return errors.Newf("no version specified for component %q", r)
+		}
+	}
+	return nil
+}
+
+func (o *Command) Run() error {
+	session := ocm.NewSession(nil)
+	defer session.Close()
+
+	err := o.ProcessOnOptions(ocmcommon.CompleteOptionsWithSession(o.Context, session))
+	if err != nil {
+		return err
+	}
+
+	hdlr := comphdlr.NewTypeHandler(o.Context.OCM(), session, repooption.From(o).Repository)
+	return utils.HandleOutput(&action{cmd: o}, hdlr, utils.StringElemSpecs(o.Refs...)...)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type action struct {
+	data comphdlr.Objects
+	cmd  *Command
+}
+
+var _ output.Output = (*action)(nil)
+
+func (d *action) Add(e interface{}) error {
+	d.data = append(d.data, e.(*comphdlr.Object))
+	return nil
+}
+
+func (d *action) Close() error {
+	return nil
+}
+
+func (d *action) Out() error {
+	list := errors.ErrListf("deleting component versions")
+	for _, e := range d.data {
+		nv := common.VersionedElementKey(e.ComponentVersion)
+		err := d.Delete(e)
+		switch {
+		case err == nil:
+			out.Outf(d.cmd.Context, "%s deleted\n", nv)
+		case errors.IsErrNotSupported(err):
+			list.Add(errors.Wrapf(err, "%s: deletion rejected by repository", nv))
+			out.Outf(d.cmd.Context, "%s rejected: %s\n", nv, err)
+		default:
+			list.Add(errors.Wrapf(err, "%s", nv))
+			out.Outf(d.cmd.Context, "%s failed: %s\n", nv, err)
+		}
+	}
+	return list.Result()
+}
+
+func (d *action) Delete(o *comphdlr.Object) error {
+	comp, err := o.Repository.LookupComponent(o.ComponentVersion.GetName())
+	if err != nil {
+		return err
+	}
+	defer comp.Close()
+	return comp.DeleteVersion(o.ComponentVersion.GetVersion())
+}
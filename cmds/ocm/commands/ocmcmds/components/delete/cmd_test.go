@@ -0,0 +1,113 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package delete_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/grammar"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/comparch"
+	ocmctf "github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const ARCH = "/tmp/ctf"
+const PROVIDER = "mandelsoft"
+const VERSION1 = "v1"
+const VERSION2 = "v2"
+const COMPONENT = "github.com/mandelsoft/test"
+
+var _ = Describe("Delete Component Version", func() {
+	var env *TestEnv
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Component(COMPONENT, func() {
+				env.Version(VERSION1, func() {
+					env.Provider(PROVIDER)
+					env.Resource("testdata", "", "PlainText", metav1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata1")
+					})
+				})
+				env.Version(VERSION2, func() {
+					env.Provider(PROVIDER)
+					env.Resource("testdata", "", "PlainText", metav1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata2")
+					})
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("deletes single component version from ctf file", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("delete", "componentversion", "--repo", ARCH, COMPONENT+grammar.VersionSeparator+VERSION1)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+github.com/mandelsoft/test:v1 deleted
+`))
+		repo, err := ocmctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		defer Close(repo)
+		comp, err := repo.LookupComponent(COMPONENT)
+		Expect(err).To(Succeed())
+		defer Close(comp)
+		Expect(comp.ListVersions()).To(ConsistOf(VERSION2))
+
+		blobs := ARCH + "/" + ctf.BlobsDirectoryName + "/"
+		Expect(env.FileExists(blobs + common.DigestToFileName(accessio.BlobAccessForString("", "testdata1").Digest()))).To(BeFalse())
+		Expect(env.FileExists(blobs + common.DigestToFileName(accessio.BlobAccessForString("", "testdata2").Digest()))).To(BeTrue())
+	})
+
+	It("rejects component without version", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("delete", "componentversion", "--repo", ARCH, COMPONENT)).To(HaveOccurred())
+		repo, err := ocmctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		defer Close(repo)
+		comp, err := repo.LookupComponent(COMPONENT)
+		Expect(err).To(Succeed())
+		defer Close(comp)
+		Expect(comp.ListVersions()).To(ConsistOf(VERSION1, VERSION2))
+	})
+
+	It("rejects component archive", func() {
+		env.ComponentArchive("/tmp/ca", accessio.FormatDirectory, COMPONENT, VERSION1, func() {
+			env.Provider(PROVIDER)
+		})
+		buf := bytes.NewBuffer(nil)
+		err := env.CatchOutput(buf).Execute("delete", "componentversion", "--repo", "/tmp/ca", COMPONENT+grammar.VersionSeparator+VERSION1)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal(`{deleting component versions: github.com/mandelsoft/test:v1: deletion rejected by repository: function "delete version" not supported by ComponentArchive}`))
+		Expect(buf.String()).To(Equal(`github.com/mandelsoft/test:v1 rejected: function "delete version" not supported by ComponentArchive
+`))
+		Expect(env.FileExists("/tmp/ca/" + comparch.ComponentDescriptorFileName)).To(BeTrue())
+	})
+})
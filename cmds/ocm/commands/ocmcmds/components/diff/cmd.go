@@ -0,0 +1,260 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package diff
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/closureoption"
+	ocmcommon "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/handlers/comphdlr"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/lookupoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/repooption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/output"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/processing"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/out"
+)
+
+var (
+	Names = names.Components
+	Verb  = verbs.Diff
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	Refs []string
+}
+
+// NewCommand creates a new diff command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New(), output.OutputOptions(outputs, closureoption.New("component reference"), lookupoption.New()))}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "[<options>] <component-reference> <component-reference>",
+		Args:  cobra.ExactArgs(2),
+		Short: "show differences between two component versions",
+		Long: `
+Diff compares the component descriptors of two component versions.
+The first component version is used as base version, the differences
+are shown relative to this version.
+
+Resources, sources and component references are matched by their
+identity. Added and removed elements are reported as well as
+modifications of versions, types, relations, digests, access
+specifications and labels. Additionally, the name, version, provider
+and labels of the component versions are compared.
+
+If the closure option is given, all component references found
+in both component versions are followed and their component versions
+are compared, also.
+`,
+		Example: `
+$ ocm diff componentversion ghcr.io/mandelsoft/kubelink:0.1.0 ghcr.io/mandelsoft/kubelink:0.2.0
+$ ocm diff componentversion --repo OCIRegistry:ghcr.io -c mandelsoft/kubelink:0.1.0 mandelsoft/kubelink:0.2.0
+`,
+	}
+}
+
+func (o *Command) Complete(args []string) error {
+	o.Refs = args
+	return nil
+}
+
+func (o *Command) Run() error {
+	session := ocm.NewSession(nil)
+	defer session.Close()
+
+	err := o.ProcessOnOptions(ocmcommon.CompleteOptionsWithSession(o, session))
+	if err != nil {
+		return err
+	}
+
+	handler := comphdlr.NewTypeHandler(o.Context.OCM(), session, repooption.From(o).Repository)
+	var versions [2]*comphdlr.Object
+	for i, ref := range o.Refs {
+		result, err := handler.Get(utils.StringSpec(ref))
+		if err != nil {
+			return errors.Wrapf(err, "error processing %q", ref)
+		}
+		if len(result) != 1 {
+			return fmt.Errorf("%q must describe exactly one component version", ref)
+		}
+		versions[i] = result[0].(*comphdlr.Object)
+		if versions[i].ComponentVersion == nil {
+			return errors.ErrNotFound(ocm.KIND_COMPONENTVERSION, ref)
+		}
+	}
+
+	d := &differ{
+		session: session,
+		ctx:     o.Context,
+		lookup:  lookupoption.From(o),
+		closure: closureoption.From(o).IsTrue(),
+		output:  output.From(o).Output,
+	}
+	err = d.diff(common.History{}, versions[0].Repository, versions[0].ComponentVersion, versions[1].Repository, versions[1].ComponentVersion)
+	if err != nil {
+		return err
+	}
+	err = d.output.Close()
+	if err != nil {
+		return err
+	}
+	return d.output.Out()
+}
+
+type differ struct {
+	session ocm.Session
+	ctx     out.Context
+	lookup  ocm.ComponentVersionResolver
+	closure bool
+	output  output.Output
+}
+
+func (d *differ) diff(hist common.History, orepo ocm.Repository, ocv ocm.ComponentVersionAccess, nrepo ocm.Repository, ncv ocm.ComponentVersionAccess) error {
+	odesc := ocv.GetDescriptor()
+	ndesc := ncv.GetDescriptor()
+
+	diff := odesc.Diff(ndesc)
+	for _, e := range diff {
+		err := d.output.Add(&Object{
+			History:   hist,
+			Component: common.VersionedElementKey(ocv),
+			Entry:     e,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if !d.closure {
+		return nil
+	}
+
+	key := common.VersionedElementKey(ocv)
+	if err := hist.Add(ocm.KIND_COMPONENTVERSION, key); err != nil {
+		return nil
+	}
+	for _, oref := range odesc.References {
+		id := oref.GetIdentity(odesc.References)
+		nref, err := ndesc.GetReferenceByIdentity(id)
+		if err != nil {
+			continue
+		}
+		onested := d.lookupVersion(hist, orepo, oref.ComponentName, oref.Version)
+		nnested := d.lookupVersion(hist, nrepo, nref.ComponentName, nref.Version)
+		if onested == nil || nnested == nil {
+			continue
+		}
+		err = d.diff(hist.Copy(), orepo, onested, nrepo, nnested)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *differ) lookupVersion(hist common.History, repo ocm.Repository, name, vers string) ocm.ComponentVersionAccess {
+	nested, err := d.session.LookupComponentVersion(repo, name, vers)
+	if err != nil {
+		out.Errf(d.ctx, "Warning: lookup nested component version %q:%s [%s]: %s\n", name, vers, hist, err)
+	}
+	if nested == nil && d.lookup != nil {
+		nested, err = d.lookup.LookupComponentVersion(name, vers)
+		if err != nil {
+			out.Errf(d.ctx, "Warning: fallback lookup nested component version \"%s:%s\" [%s]: %s\n", name, vers, hist, err)
+		}
+	}
+	if err != nil {
+		return nil
+	}
+	return nested
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Object struct {
+	History   common.History
+	Component common.NameVersion
+	Entry     compdesc.DiffEntry
+}
+
+var _ common.HistorySource = (*Object)(nil)
+
+type Manifest struct {
+	History            common.History     `json:"context"`
+	Component          common.NameVersion `json:"component"`
+	compdesc.DiffEntry `json:",inline"`
+}
+
+func (o *Object) AsManifest() interface{} {
+	h := o.History
+	if h == nil {
+		h = common.History{}
+	}
+	return &Manifest{
+		History:   h,
+		Component: o.Component,
+		DiffEntry: o.Entry,
+	}
+}
+
+func (o *Object) GetHistory() common.History {
+	return o.History
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+var outputs = output.NewOutputs(getRegular).AddManifestOutputs()
+
+func TableOutput(opts *output.Options, mapping processing.MappingFunction) *output.TableOutput {
+	return &output.TableOutput{
+		Headers: output.Fields("COMPONENT", "KIND", "IDENTITY", "CHANGE", "FIELD", "OLD", "NEW"),
+		Options: opts,
+		Mapping: mapping,
+	}
+}
+
+func getRegular(opts *output.Options) output.Output {
+	return closureoption.TableOutput(TableOutput(opts, mapGetRegularOutput)).New()
+}
+
+func mapGetRegularOutput(e interface{}) interface{} {
+	p := e.(*Object)
+	id := ""
+	if p.Entry.Identity != nil {
+		id = p.Entry.Identity.String()
+	}
+	return []string{p.Component.String(), p.Entry.Kind, id, p.Entry.Change, p.Entry.Field, value(p.Entry.Old), value(p.Entry.New)}
+}
+
+func value(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,148 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package diff_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartefact"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+)
+
+const ARCH = "/tmp/ctf"
+const COMP = "test.de/x"
+const COMP2 = "test.de/y"
+const PROVIDER = "mandelsoft"
+
+var _ = Describe("Test Environment", func() {
+	var env *TestEnv
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Component(COMP2, func() {
+				env.Version("v1", func() {
+					env.Provider(PROVIDER)
+					env.Resource("data", "v1", "PlainText", metav1.ExternalRelation, func() {
+						env.Access(ociartefact.New("ghcr.io/acme/data:v1"))
+					})
+				})
+				env.Version("v2", func() {
+					env.Provider(PROVIDER)
+					env.Resource("data", "v2", "PlainText", metav1.ExternalRelation, func() {
+						env.Access(ociartefact.New("ghcr.io/acme/data:v2"))
+					})
+				})
+			})
+			env.Component(COMP, func() {
+				env.Version("v1", func() {
+					env.Provider(PROVIDER)
+					env.Reference("ref", COMP2, "v1", func() {
+						env.Label("purpose", "test")
+					})
+					env.Reference("other", COMP2, "v1")
+				})
+				env.Version("v2", func() {
+					env.Provider(PROVIDER)
+					env.Reference("ref", COMP2, "v2", func() {
+						env.Label("purpose", "production")
+					})
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("shows differences", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("diff", "components", "--repo", ARCH, COMP+":v1", COMP+":v2")).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+COMPONENT    KIND      IDENTITY       CHANGE   FIELD          OLD                               NEW
+test.de/x:v1 component                modified version        v1                                v2
+test.de/x:v1 reference "name"="ref"   modified version        v1                                v2
+test.de/x:v1 reference "name"="ref"   modified labels.purpose {"name":"purpose","value":"test"} {"name":"purpose","value":"production"}
+test.de/x:v1 reference "name"="other" removed                 -                                 -
+`))
+	})
+
+	It("shows differences of closure", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("diff", "components", "-c", "--repo", ARCH, COMP+":v1", COMP+":v2")).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+REFERENCEPATH COMPONENT    KIND      IDENTITY       CHANGE   FIELD          OLD                                                            NEW
+              test.de/x:v1 component                modified version        v1                                                             v2
+              test.de/x:v1 reference "name"="ref"   modified version        v1                                                             v2
+              test.de/x:v1 reference "name"="ref"   modified labels.purpose {"name":"purpose","value":"test"}                              {"name":"purpose","value":"production"}
+              test.de/x:v1 reference "name"="other" removed                 -                                                              -
+test.de/x:v1  test.de/y:v1 component                modified version        v1                                                             v2
+test.de/x:v1  test.de/y:v1 resource  "name"="data"  modified version        v1                                                             v2
+test.de/x:v1  test.de/y:v1 resource  "name"="data"  modified access         {"imageReference":"ghcr.io/acme/data:v1","type":"ociArtefact"} {"imageReference":"ghcr.io/acme/data:v2","type":"ociArtefact"}
+`))
+	})
+
+	It("shows differences as yaml", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("diff", "components", "-o", "yaml", "--repo", ARCH, COMP+":v1", COMP+":v2")).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+---
+change: modified
+component: test.de/x:v1
+context: []
+field: version
+kind: component
+new: v2
+old: v1
+---
+change: modified
+component: test.de/x:v1
+context: []
+field: version
+identity:
+  name: ref
+kind: reference
+new: v2
+old: v1
+---
+change: modified
+component: test.de/x:v1
+context: []
+field: labels.purpose
+identity:
+  name: ref
+kind: reference
+new: '{"name":"purpose","value":"production"}'
+old: '{"name":"purpose","value":"test"}'
+---
+change: removed
+component: test.de/x:v1
+context: []
+identity:
+  name: other
+kind: reference
+`))
+	})
+})
@@ -35,7 +35,10 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
 	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/ecdsa"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/ed25519"
 	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/sigstore"
 )
 
 const ARCH = "/tmp/ctf"
@@ -57,6 +60,9 @@ const SIGN_ALGO = rsa.Algorithm
 const PUBKEY = "/tmp/pub"
 const PRIVKEY = "/tmp/priv"
 
+const OTHERPUBKEY = "/tmp/otherpub"
+const OTHERPRIVKEY = "/tmp/otherpriv"
+
 var _ = Describe("access method", func() {
 	var env *TestEnv
 
@@ -139,6 +145,42 @@ successfully signed github.com/mandelsoft/ref:v1 (digest sha256:` + digest + `)
 			Expect(cv.GetDescriptor().Signatures[0].Digest.Value).To(Equal(digest))
 		})
 
+		DescribeTable("sign and verify with other key types", func(algo, mediatype string, create func() (interface{}, interface{}, error), keydata func(interface{}) ([]byte, error)) {
+			prepareEnv(env, ARCH, ARCH)
+
+			priv, pub, err := create()
+			Expect(err).To(Succeed())
+			data, err := keydata(pub)
+			Expect(err).To(Succeed())
+			Expect(vfs.WriteFile(env.FileSystem(), OTHERPUBKEY, data, os.ModePerm)).To(Succeed())
+			data, err = keydata(priv)
+			Expect(err).To(Succeed())
+			Expect(vfs.WriteFile(env.FileSystem(), OTHERPRIVKEY, data, os.ModePerm)).To(Succeed())
+
+			buf := bytes.NewBuffer(nil)
+			Expect(env.CatchOutput(buf).Execute("sign", "components", "-s", SIGNATURE, "-S", algo, "-K", OTHERPRIVKEY, "--repo", ARCH, COMPONENTB+":"+VERSION)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("successfully signed github.com/mandelsoft/ref:v1"))
+
+			session := datacontext.NewSession()
+			defer session.Close()
+
+			src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+			Expect(err).To(Succeed())
+			session.AddCloser(src)
+			cv, err := src.LookupComponentVersion(COMPONENTB, VERSION)
+			Expect(err).To(Succeed())
+			session.AddCloser(cv)
+			Expect(cv.GetDescriptor().Signatures[0].Signature.Algorithm).To(Equal(algo))
+			Expect(cv.GetDescriptor().Signatures[0].Signature.MediaType).To(Equal(mediatype))
+
+			buf.Reset()
+			Expect(env.CatchOutput(buf).Execute("verify", "components", "-s", SIGNATURE, "-k", OTHERPUBKEY, "--repo", ARCH, COMPONENTB+":"+VERSION)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("successfully verified github.com/mandelsoft/ref:v1"))
+		},
+			Entry("ecdsa", ecdsa.Algorithm, ecdsa.MediaType, ecdsa.Handler{}.CreateKeyPair, ecdsa.KeyData),
+			Entry("sigstore", sigstore.Algorithm, sigstore.MediaType, ecdsa.Handler{}.CreateKeyPair, ecdsa.KeyData),
+			Entry("ed25519", ed25519.Algorithm, ed25519.MediaType, ed25519.Handler{}.CreateKeyPair, ed25519.KeyData),
+		)
 	})
 
 	Context("incomplete ctf", func() {
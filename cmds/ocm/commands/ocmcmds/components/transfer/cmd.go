@@ -17,20 +17,28 @@ package transfer
 import (
 	"fmt"
 
+	"github.com/mandelsoft/vfs/pkg/layerfs"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/closureoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/formatoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/platformoption"
 	ocmcommon "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/handlers/comphdlr"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/journaloption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/lookupoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/overwriteoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/paralleloption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/repooption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/rscbyvalueoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/scriptoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/names"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/output"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/processing"
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
 	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
@@ -51,6 +59,7 @@ type Command struct {
 
 	Refs       []string
 	TargetName string
+	DryRun     bool
 }
 
 // NewCommand creates a new ctf command.
@@ -62,7 +71,11 @@ func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
 		lookupoption.New(),
 		overwriteoption.New(),
 		rscbyvalueoption.New(),
+		platformoption.NewForComponentVersions(),
 		scriptoption.New(),
+		paralleloption.New(),
+		journaloption.New(),
+		output.OutputOptions(outputs),
 	)}, utils.Names(Names, names...)...)
 }
 
@@ -75,20 +88,36 @@ func (o *Command) ForName(name string) *cobra.Command {
 Transfer all component versions specified to the given target repository.
 If only a component (instead of a component version) is specified all versions
 are transferred.
+
+If the option <code>--dry-run</code> is given, nothing is written to the
+target repository. Instead, the decisions of the transfer handler are shown:
+which component versions would be copied, overwritten or skipped, and
+which resources and sources would be transferred by value or by reference,
+together with the expected access type and OCI repository in the target.
+The plan output format can be selected with option <code>--output</code>.
 `,
 		Example: `
 $ ocm transfer components -t tgz ghcr.io/mandelsoft/kubelink ctf.tgz
 $ ocm transfer components -t tgz --repo OCIRegistry:ghcr.io mandelsoft/kubelink ctf.tgz
+$ ocm transfer components --dry-run -o yaml --closure ghcr.io/mandelsoft/kubelink ghcr.io/acme
 `,
 	}
 }
 
+func (o *Command) AddFlags(fs *pflag.FlagSet) {
+	o.BaseCommand.AddFlags(fs)
+	fs.BoolVarP(&o.DryRun, "dry-run", "", false, "only show the transfer plan")
+}
+
 func (o *Command) Complete(args []string) error {
 	o.Refs = args[:len(args)-1]
 	if len(args) == 0 && repooption.From(o).Spec == "" {
 		return fmt.Errorf("a repository or at least one argument that defines the reference is required")
 	}
 	o.TargetName = args[len(args)-1]
+	if !o.DryRun && output.From(o).OutputMode != "" {
+		return fmt.Errorf("output mode %q only possible for --dry-run", output.From(o).OutputMode)
+	}
 	return nil
 }
 
@@ -100,7 +129,18 @@ func (o *Command) Run() error {
 	if err != nil {
 		return err
 	}
-	target, err := ocm.AssureTargetRepository(session, o.Context.OCMContext(), o.TargetName, ocm.CommonTransportFormat, formatoption.From(o).Format, o.Context.FileSystem())
+	var fs vfs.FileSystem = o.Context.FileSystem()
+	if o.DryRun {
+		// file based targets must not be created or modified for a dry-run
+		fs = layerfs.New(memoryfs.New(), fs)
+	}
+	target, err := ocm.AssureTargetRepository(session, o.Context.OCMContext(), o.TargetName, ocm.CommonTransportFormat, formatoption.From(o).Format, fs)
+	if err != nil {
+		return err
+	}
+
+	jopt := journaloption.From(o)
+	err = jopt.Open(o.Context, target)
 	if err != nil {
 		return err
 	}
@@ -109,7 +149,10 @@ func (o *Command) Run() error {
 		closureoption.From(o),
 		overwriteoption.From(o),
 		rscbyvalueoption.From(o),
+		platformoption.From(o),
 		lookupoption.From(o),
+		paralleloption.From(o),
+		jopt,
 		spiff.Script(scriptoption.From(o).ScriptData),
 		spiff.ScriptFilesystem(o.FileSystem()),
 	)
@@ -117,6 +160,15 @@ func (o *Command) Run() error {
 		return err
 	}
 	hdlr := comphdlr.NewTypeHandler(o.Context.OCM(), session, repooption.From(o).Repository)
+	if o.DryRun {
+		return utils.HandleOutput(&planAction{
+			Output:  output.From(o).Output,
+			target:  target,
+			handler: thdlr,
+			closure: transfer.TransportClosure{},
+			errors:  errors.ErrListf("transfer plan errors"),
+		}, hdlr, utils.StringElemSpecs(o.Refs...)...)
+	}
 	err = utils.HandleOutput(&action{
 		cmd:     o,
 		printer: common.NewPrinter(o.Context.StdOut()),
@@ -128,6 +180,10 @@ func (o *Command) Run() error {
 	if err != nil {
 		return err
 	}
+	err = jopt.Finish()
+	if err != nil {
+		return err
+	}
 	return session.Close()
 }
 
@@ -165,3 +221,89 @@ func (a *action) Out() error {
 	}
 	return nil
 }
+
+/////////////////////////////////////////////////////////////////////////////
+
+// planAction feeds the transfer plans of the given component versions
+// into the selected output.
+type planAction struct {
+	output.Output
+	target  ocm.Repository
+	handler transferhandler.TransferHandler
+	closure transfer.TransportClosure
+	errors  *errors.ErrorList
+}
+
+func (a *planAction) Add(e interface{}) error {
+	o := e.(*comphdlr.Object)
+	plans, err := transfer.PlanVersion(a.closure, o.ComponentVersion, a.target, a.handler)
+	for _, p := range plans {
+		if err := a.Output.Add(p); err != nil {
+			return err
+		}
+	}
+	a.errors.Add(err)
+	return nil
+}
+
+func (a *planAction) Out() error {
+	err := a.Output.Out()
+	if err != nil {
+		return err
+	}
+	return a.errors.Result()
+}
+
+/////////////////////////////////////////////////////////////////////////////
+
+var outputs = output.NewOutputs(getPlan).AddManifestOutputs()
+
+// planOutput prints the transfer plan as table with a line for every
+// component version and its transfer action followed by a line for each
+// of its resources and sources showing the transfer mode and the
+// planned target access. It only exposes the output.Output interface of
+// the table output to hide its sort fields, because the transfer
+// command already uses the short option -s.
+type planOutput struct {
+	output.Output
+}
+
+func getPlan(opts *output.Options) output.Output {
+	return &planOutput{(&output.TableOutput{
+		Headers: output.Fields("COMPONENT", "VERSION", "ACTION", "KIND", "NAME", "MODE", "TARGET ACCESS", "TARGET REF"),
+		Options: opts,
+		Chain:   processing.Explode(explodePlan),
+		Mapping: mapPlan,
+	}).New()}
+}
+
+type planElement struct {
+	*transfer.VersionPlan
+	kind     string
+	artefact *transfer.ArtefactPlan
+}
+
+func explodePlan(e interface{}) []interface{} {
+	p := e.(*transfer.VersionPlan)
+	result := []interface{}{&planElement{VersionPlan: p}}
+	for _, a := range p.Resources {
+		result = append(result, &planElement{p, "resource", a})
+	}
+	for _, a := range p.Sources {
+		result = append(result, &planElement{p, "source", a})
+	}
+	return result
+}
+
+func mapPlan(e interface{}) interface{} {
+	p := e.(*planElement)
+	if p.artefact == nil {
+		return []string{p.Component, p.Version, p.Action, "", "", "", "", ""}
+	}
+	a := p.artefact
+	mode := a.Mode
+	if a.Error != "" {
+		mode = "error: " + a.Error
+	}
+	return []string{"", "", "", p.kind, a.Name, mode, a.TargetAccess, a.TargetRef}
+}
@@ -17,6 +17,7 @@ package transfer_test
 import (
 	"bytes"
 	"encoding/json"
+	"regexp"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/open-component-model/ocm/pkg/common/accessio"
 	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
 	"github.com/open-component-model/ocm/pkg/contexts/oci"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
@@ -49,6 +51,7 @@ const OCINAMESPACE = "ocm/value"
 const OCINAMESPACE2 = "ocm/ref"
 const OCIVERSION = "v2.0"
 const OCIHOST = "alias"
+const JOURNAL = "/tmp/journal.json"
 
 func Check(env *TestEnv, ldesc *artdesc.Descriptor, out string) {
 	tgt, err := ctfocm.Open(env.OCMContext(), accessobj.ACC_READONLY, out, 0, accessio.PathFileSystem(env.FileSystem()))
@@ -203,6 +206,96 @@ transferring version "github.com/mandelsoft/test2:v1"...
 		CheckComponent(env, ldesc, tgt)
 	})
 
+	It("transfers ctf with --parallel --closure --lookup", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("transfer", "components", "--parallel", "3", "--resourcesByValue", "--closure", "--lookup", ARCH, ARCH2, ARCH2, OUT)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring(`transferring version "github.com/mandelsoft/test2:v1"...`))
+		Expect(buf.String()).To(ContainSubstring(`  transferring version "github.com/mandelsoft/test:v1"...`))
+		Expect(buf.String()).To(ContainSubstring(`...resource 1(ocm/value:v2.0)...`))
+		Expect(buf.String()).To(ContainSubstring(`...resource 2(ocm/ref:v2.0)...`))
+		Expect(buf.String()).To(HaveSuffix("2 versions transferred\n"))
+
+		Expect(env.DirExists(OUT)).To(BeTrue())
+		tgt, err := ctfocm.Open(env.OCMContext(), accessobj.ACC_READONLY, OUT, 0, accessio.PathFileSystem(env.FileSystem()))
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+
+		list, err := tgt.ComponentLister().GetComponents("", true)
+		Expect(err).To(Succeed())
+		Expect(list).To(ContainElements([]string{COMPONENT2, COMPONENT}))
+
+		CheckComponent(env, ldesc, tgt)
+	})
+
+	It("skips component versions recorded in the journal", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("transfer", "components", "--journal", JOURNAL, "--resourcesByValue", ARCH, ARCH, OUT)).To(Succeed())
+		Expect(env.FileExists(JOURNAL)).To(BeTrue())
+
+		buf.Reset()
+		Expect(env.CatchOutput(buf).Execute("transfer", "components", "--journal", JOURNAL, "--resume", "--overwrite", "--resourcesByValue", ARCH, ARCH, OUT)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+transferring version "github.com/mandelsoft/test:v1"...
+  version "github.com/mandelsoft/test:v1" already transferred -> skip transport
+1 versions transferred
+`))
+		Check(env, ldesc, OUT)
+	})
+
+	It("shows transfer plan for --dry-run", func() {
+		fs := vfsattr.Get(env.OCIContext())
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("transfer", "components", "--dry-run", "--closure", "--lookup", ARCH, ARCH2, ARCH2, OUT)).To(Succeed())
+		Expect(regexp.MustCompile("(?m) +$").ReplaceAllString(buf.String(), "")).To(StringEqualTrimmedWithContext(`
+COMPONENT                   VERSION ACTION KIND     NAME     MODE      TARGET ACCESS TARGET REF
+github.com/mandelsoft/test2 v1      copy
+github.com/mandelsoft/test  v1      copy
+                                           resource testdata local     localBlob
+                                           resource value    reference ociArtefact
+                                           resource ref      reference ociArtefact
+`))
+		Expect(env.DirExists(OUT)).To(BeFalse())
+		Expect(vfsattr.Get(env.OCIContext())).To(BeIdenticalTo(fs))
+	})
+
+	It("shows transfer plan as yaml for --dry-run", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("transfer", "components", "--dry-run", "-o", "yaml", "--resourcesByValue", ARCH, ARCH, OUT)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+---
+action: copy
+component: github.com/mandelsoft/test
+resources:
+- access: localBlob
+  index: 0
+  mode: local
+  name: testdata
+  targetAccess: localBlob
+  type: PlainText
+  version: v1
+- access: ociArtefact
+  index: 1
+  mode: value
+  name: value
+  targetAccess: localBlob
+  type: ociImage
+  version: v1
+- access: ociArtefact
+  index: 2
+  mode: value
+  name: ref
+  targetAccess: localBlob
+  type: ociImage
+  version: v1
+version: v1
+`))
+		Expect(env.DirExists(OUT)).To(BeFalse())
+	})
+
+	It("rejects output mode without --dry-run", func() {
+		Expect(env.Execute("transfer", "components", "-o", "yaml", ARCH, ARCH, OUT)).To(MatchError(`output mode "yaml" only possible for --dry-run`))
+	})
+
 	It("transfers ctf to tgz", func() {
 		buf := bytes.NewBuffer(nil)
 		Expect(env.CatchOutput(buf).Execute("transfer", "components", "--resourcesByValue", ARCH, ARCH, accessio.FormatTGZ.String()+"::"+OUT)).To(Succeed())
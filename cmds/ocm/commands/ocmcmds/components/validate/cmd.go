@@ -0,0 +1,206 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package validate
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/closureoption"
+	ocmcommon "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/handlers/comphdlr"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/lookupoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/repooption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/output"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/processing"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/utils/validate"
+)
+
+var (
+	Names = names.Components
+	Verb  = verbs.Validate
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	Refs []string
+}
+
+// NewCommand creates a new validate command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New(), output.OutputOptions(outputs, closureoption.New("component reference"), lookupoption.New()))}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "[<options>] {<component-reference>}",
+		Short: "validate component versions",
+		Long: `
+Validate checks the component versions specified for semantic problems
+not covered by the schema validation of component descriptors.
+All problems are reported as findings with a severity
+(<code>error</code>, <code>warning</code> or <code>info</code>).
+
+The following checks are executed:
+- component versions must be found (error)
+- uniqueness of the identities of resources, sources and component references (error)
+- source references of resources must match a source (error)
+- resources must have an access specification (error)
+- blobs of local access specifications must exist in the repository (error)
+- component references must be resolvable (warning)
+- resources with relation <code>local</code> should use a local access specification (warning)
+- resources and component references should have a digest (warning)
+- labels without the <code>signing</code> flag are not covered by signatures (info)
+
+If findings with severity <code>error</code> are found, the command fails.
+`,
+		Example: `
+$ ocm validate componentversion ghcr.io/mandelsoft/kubelink:0.1.0
+$ ocm validate componentversion -o json --repo ./ctf --closure mandelsoft/kubelink
+`,
+	}
+}
+
+func (o *Command) Complete(args []string) error {
+	o.Refs = args
+	if len(args) == 0 && repooption.From(o).Spec == "" {
+		return fmt.Errorf("a repository or at least one argument that defines the reference is needed")
+	}
+	return nil
+}
+
+func (o *Command) Run() error {
+	session := ocm.NewSession(nil)
+	defer session.Close()
+
+	err := o.ProcessOnOptions(ocmcommon.CompleteOptionsWithSession(o, session))
+	if err != nil {
+		return err
+	}
+
+	opts := output.From(o)
+	handler := comphdlr.NewTypeHandler(o.Context.OCM(), session, repooption.From(o).Repository)
+	comps := output.NewElementOutput(nil, closureoption.Closure(opts, comphdlr.ClosureExplode, comphdlr.Sort))
+	err = utils.HandleOutput(comps, handler, utils.StringElemSpecs(o.Refs...)...)
+	if err != nil {
+		return err
+	}
+
+	errs := 0
+	i := comps.Elems.Iterator()
+	for i.HasNext() {
+		c := i.Next().(*comphdlr.Object)
+		var findings validate.Findings
+		var nv common.NameVersion
+		if c.ComponentVersion == nil {
+			nv = c.Spec.NameVersion()
+			findings = validate.Findings{{
+				Severity: validate.SEVERITY_ERROR,
+				Kind:     validate.KIND_COMPONENT,
+				Message:  "component version not found",
+			}}
+		} else {
+			nv = common.VersionedElementKey(c.ComponentVersion)
+			findings = validate.ComponentVersion(c.ComponentVersion, lookupoption.From(o))
+		}
+		errs += findings.Count(validate.SEVERITY_ERROR)
+		for _, f := range findings {
+			err := opts.Output.Add(&Object{
+				History:   c.History,
+				Component: nv,
+				Finding:   f,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err = opts.Output.Close()
+	if err == nil {
+		err = opts.Output.Out()
+	}
+	if err != nil {
+		return err
+	}
+	if errs > 0 {
+		return fmt.Errorf("validation failed: %d error(s) found", errs)
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Object struct {
+	History   common.History
+	Component common.NameVersion
+	Finding   validate.Finding
+}
+
+var _ common.HistorySource = (*Object)(nil)
+
+type Manifest struct {
+	History          common.History     `json:"context"`
+	Component        common.NameVersion `json:"component"`
+	validate.Finding `json:",inline"`
+}
+
+func (o *Object) AsManifest() interface{} {
+	h := o.History
+	if h == nil {
+		h = common.History{}
+	}
+	return &Manifest{
+		History:   h,
+		Component: o.Component,
+		Finding:   o.Finding,
+	}
+}
+
+func (o *Object) GetHistory() common.History {
+	return o.History
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+var outputs = output.NewOutputs(getRegular).AddManifestOutputs()
+
+func TableOutput(opts *output.Options, mapping processing.MappingFunction) *output.TableOutput {
+	return &output.TableOutput{
+		Headers: output.Fields("COMPONENT", "SEVERITY", "KIND", "IDENTITY", "MESSAGE"),
+		Options: opts,
+		Mapping: mapping,
+	}
+}
+
+func getRegular(opts *output.Options) output.Output {
+	return closureoption.TableOutput(TableOutput(opts, mapGetRegularOutput)).New()
+}
+
+func mapGetRegularOutput(e interface{}) interface{} {
+	p := e.(*Object)
+	id := ""
+	if p.Finding.Identity != nil {
+		id = p.Finding.Identity.String()
+	}
+	return []string{p.Component.String(), p.Finding.Severity, p.Finding.Kind, id, p.Finding.Message}
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package validate_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartefact"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const ARCH = "/tmp/ctf"
+const COMP = "test.de/x"
+const COMP2 = "test.de/y"
+const VERSION = "v1"
+const PROVIDER = "mandelsoft"
+
+var _ = Describe("Test Environment", func() {
+	var env *TestEnv
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Component(COMP, func() {
+				env.Version(VERSION, func() {
+					env.Provider(PROVIDER)
+					env.Resource("data", "", "PlainText", metav1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata")
+					})
+					env.Resource("image", "", "ociImage", metav1.LocalRelation, func() {
+						env.Access(ociartefact.New("ghcr.io/acme/image:v1"))
+						env.Label("purpose", "test")
+					})
+					env.Reference("ref", COMP2, VERSION)
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("validates component version", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("validate", "components", "--repo", ARCH, COMP+":"+VERSION)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+COMPONENT    SEVERITY KIND      IDENTITY       MESSAGE
+test.de/x:v1 warning  resource  "name"="data"  no digest
+test.de/x:v1 info     resource  "name"="image" label "purpose" not covered by signatures
+test.de/x:v1 warning  resource  "name"="image" no digest
+test.de/x:v1 warning  resource  "name"="image" local resource uses non-local access
+test.de/x:v1 warning  reference "name"="ref"   no digest
+test.de/x:v1 warning  reference "name"="ref"   component reference "ref[test.de/y:v1]" not found
+`))
+	})
+
+	It("fails for missing local blob", func() {
+		fs := env.FileSystem()
+		entries, err := vfs.ReadDir(fs, ARCH+"/blobs")
+		Expect(err).To(Succeed())
+		for _, e := range entries {
+			data, err := vfs.ReadFile(fs, ARCH+"/blobs/"+e.Name())
+			Expect(err).To(Succeed())
+			if string(data) == "testdata" {
+				Expect(fs.Remove(ARCH + "/blobs/" + e.Name())).To(Succeed())
+			}
+		}
+
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("validate", "components", "-o", "yaml", "--repo", ARCH, COMP+":"+VERSION)).To(MatchError("validation failed: 1 error(s) found"))
+		Expect(buf.String()).To(ContainSubstring(`
+identity:
+  name: data
+kind: resource
+message: 'local blob not accessible:`))
+		Expect(buf.String()).To(ContainSubstring(`severity: error`))
+	})
+
+	It("reports component versions not found", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("validate", "components", "--closure", "--repo", ARCH, COMP+":"+VERSION)).To(MatchError("validation failed: 1 error(s) found"))
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+REFERENCEPATH COMPONENT    SEVERITY KIND      IDENTITY       MESSAGE
+              test.de/x:v1 warning  resource  "name"="data"  no digest
+              test.de/x:v1 info     resource  "name"="image" label "purpose" not covered by signatures
+              test.de/x:v1 warning  resource  "name"="image" no digest
+              test.de/x:v1 warning  resource  "name"="image" local resource uses non-local access
+              test.de/x:v1 warning  reference "name"="ref"   no digest
+              test.de/x:v1 warning  reference "name"="ref"   component reference "ref[test.de/y:v1]" not found
+test.de/x:v1  test.de/y:v1 error    component                component version not found
+`))
+	})
+})
@@ -35,6 +35,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartefact"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verifyattr"
 	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
@@ -182,4 +183,42 @@ applying to version "github.com/mandelsoft/ref:v1"...
 successfully verified github.com/mandelsoft/ref:v1 (digest sha256:` + digest + `)
 `))
 	})
+
+	It("enforces verification policy", func() {
+		session := datacontext.NewSession()
+		defer session.Close()
+
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		archcloser := session.AddCloser(src)
+		resolver := ocm.NewCompoundResolver(src)
+
+		cv, err := resolver.LookupComponentVersion(COMPONENTB, VERSION)
+		Expect(err).To(Succeed())
+		closer := session.AddCloser(cv)
+
+		opts := NewOptions(
+			Sign(signing.DefaultHandlerRegistry().GetSigner(SIGN_ALGO), SIGNATURE),
+			Resolver(resolver),
+			PrivateKey(SIGNATURE, priv),
+			Update(), VerifyDigests(),
+		)
+		Expect(opts.Complete(signingattr.Get(DefaultContext))).To(Succeed())
+		_, err = Apply(nil, nil, cv, opts)
+		Expect(err).To(Succeed())
+		closer.Close()
+		archcloser.Close()
+
+		cfg := verifyattr.New()
+		cfg.AddRule(verifyattr.Rule{
+			RequiredSignatures: []string{SIGNATURE},
+			VerifyReferences:   []string{COMPONENTA},
+		})
+		Expect(env.ConfigContext().ApplyConfig(cfg, "policy")).To(Succeed())
+
+		buf := bytes.NewBuffer(nil)
+		err = env.CatchOutput(buf).Execute("verify", "components", "-s", SIGNATURE, "-k", PUBKEY, "--repo", ARCH, COMPONENTB+":"+VERSION)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("no signature found in github.com/mandelsoft/ref:v1->github.com/mandelsoft/test:v1"))
+	})
 })
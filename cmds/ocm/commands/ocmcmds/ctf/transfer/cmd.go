@@ -18,6 +18,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/formatoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/platformoption"
 	ocmcommon "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/overwriteoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/rscbyvalueoption"
@@ -54,6 +55,7 @@ func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
 		formatoption.New(),
 		overwriteoption.New(),
 		rscbyvalueoption.New(),
+		platformoption.NewForComponentVersions(),
 		scriptoption.New(),
 	)}, utils.Names(Names, names...)...)
 }
@@ -99,6 +101,7 @@ func (o *Command) Run() error {
 	thdlr, err := spiff.New(
 		spiff.Script(scriptoption.From(o).ScriptData),
 		rscbyvalueoption.From(o),
+		platformoption.From(o),
 		overwriteoption.From(o),
 		spiff.ScriptFilesystem(o.FileSystem()),
 	)
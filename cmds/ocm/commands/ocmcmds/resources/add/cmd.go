@@ -15,15 +15,20 @@
 package add
 
 import (
+	"encoding/json"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/accessoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/names"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/template"
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/errors"
 )
 
 var (
@@ -33,17 +38,21 @@ var (
 
 type Command struct {
 	common.ResourceAdderCommand
+
+	Name    string
+	Type    string
+	Version string
 }
 
 // NewCommand creates a new ctf command.
 func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
-	return utils.SetupCommand(&Command{common.ResourceAdderCommand{BaseCommand: utils.NewBaseCommand(ctx)}}, utils.Names(Names, names...)...)
+	return utils.SetupCommand(&Command{ResourceAdderCommand: common.ResourceAdderCommand{BaseCommand: utils.NewBaseCommand(ctx, accessoption.New())}}, utils.Names(Names, names...)...)
 }
 
 func (o *Command) ForName(name string) *cobra.Command {
 	return &cobra.Command{
 		Use:   "[<options>] <target> {<resourcefile> | <var>=<value>}",
-		Args:  cobra.MinimumNArgs(2),
+		Args:  cobra.MinimumNArgs(1),
 		Short: "add resources to a component version",
 		Long: `
 Add resources specified in a resource file to a component version.
@@ -55,6 +64,44 @@ to add to a component version.
 	}
 }
 
+func (o *Command) AddFlags(fs *pflag.FlagSet) {
+	o.ResourceAdderCommand.AddFlags(fs)
+	fs.StringVarP(&o.Name, "name", "", "", "resource name (used with --accessType)")
+	fs.StringVarP(&o.Type, "type", "", "", "resource type (used with --accessType)")
+	fs.StringVarP(&o.Version, "version", "", "", "resource version (used with --accessType)")
+}
+
+func (o *Command) Complete(args []string) error {
+	err := o.ResourceAdderCommand.Complete(args)
+	if err != nil {
+		return err
+	}
+	acc, err := accessoption.From(o).Build()
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		if len(o.Paths) == 0 {
+			return errors.Newf("resource specification file or access type required")
+		}
+		return nil
+	}
+	spec := map[string]interface{}{
+		"name":   o.Name,
+		"type":   o.Type,
+		"access": acc,
+	}
+	if o.Version != "" {
+		spec["version"] = o.Version
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	o.Specs = append(o.Specs, data)
+	return nil
+}
+
 func (o *Command) Run() error {
 	return o.ProcessResourceDescriptions("resources", ResourceSpecHandler{})
 }
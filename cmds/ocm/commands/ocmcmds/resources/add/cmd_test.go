@@ -15,21 +15,42 @@
 package add_test
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
 
+	"github.com/mandelsoft/vfs/pkg/vfs"
 	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/repo"
 
 	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/common/accessio"
 	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/identity"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
+	httpaccess "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/http"
+	httpidentity "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/http/identity"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localblob"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartefact"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/reproducibleattr"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
 	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/consts"
@@ -70,6 +91,57 @@ func Get(blob accessio.BlobAccess, expected []byte) []byte {
 	return data
 }
 
+func CheckArtefactSetResource(env *TestEnv, name string, main digest.Digest) *artefactset.ArtefactSet {
+	data, err := env.ReadFile(env.Join(ARCH, comparch.ComponentDescriptorFileName))
+	ExpectWithOffset(1, err).To(Succeed())
+	cd, err := compdesc.Decode(data)
+	ExpectWithOffset(1, err).To(Succeed())
+
+	r, err := cd.GetResourceByIdentity(metav1.NewIdentity(name))
+	ExpectWithOffset(1, err).To(Succeed())
+	ExpectWithOffset(1, r.Access.GetType()).To(Equal(localblob.Type))
+	acc, err := env.OCMContext().AccessSpecForSpec(r.Access)
+	ExpectWithOffset(1, err).To(Succeed())
+
+	blobpath := env.Join(ARCH, comparch.BlobsDirectoryName, common.DigestToFileName(digest.Digest(acc.(*localblob.AccessSpec).LocalReference)))
+	blob := accessio.BlobAccessForFile(mime.MIME_GZIP, blobpath, env)
+	set, err := artefactset.OpenFromBlob(accessobj.ACC_READONLY, blob)
+	ExpectWithOffset(1, err).To(Succeed())
+	ExpectWithOffset(1, set.GetMain()).To(Equal(main))
+	return set
+}
+
+func WriteLayoutBlob(env *TestEnv, path string, mediaType string, data []byte) artdesc.Descriptor {
+	dig := digest.FromBytes(data)
+	ExpectWithOffset(1, env.MkdirAll(env.Join(path, "blobs", dig.Algorithm().String()), 0o755)).To(Succeed())
+	ExpectWithOffset(1, vfs.WriteFile(env, env.Join(path, "blobs", dig.Algorithm().String(), dig.Encoded()), data, 0o644)).To(Succeed())
+	return artdesc.Descriptor{
+		MediaType: mediaType,
+		Digest:    dig,
+		Size:      int64(len(data)),
+	}
+}
+
+func WriteLayoutManifest(env *TestEnv, path string, os, arch string) artdesc.Descriptor {
+	m := artdesc.NewManifest()
+	m.Config = WriteLayoutBlob(env, path, ociv1.MediaTypeImageConfig, []byte(`{"os":"`+os+`","architecture":"`+arch+`"}`))
+	m.Layers = append(m.Layers, WriteLayoutBlob(env, path, ociv1.MediaTypeImageLayer, []byte(arch)))
+	data, err := artdesc.EncodeManifest(m)
+	ExpectWithOffset(1, err).To(Succeed())
+	desc := WriteLayoutBlob(env, path, artdesc.MediaTypeImageManifest, data)
+	desc.Platform = &artdesc.Platform{OS: os, Architecture: arch}
+	return desc
+}
+
+func WriteLayout(env *TestEnv, path string, manifests ...artdesc.Descriptor) {
+	idx := artdesc.NewIndex()
+	idx.Manifests = manifests
+	data, err := artdesc.EncodeIndex(idx)
+	ExpectWithOffset(1, err).To(Succeed())
+	ExpectWithOffset(1, vfs.WriteFile(env, env.Join(path, "index.json"), data, 0o644)).To(Succeed())
+	ExpectWithOffset(1, vfs.WriteFile(env, env.Join(path, "oci-layout"), []byte(`{"imageLayoutVersion":"1.0.0"}`), 0o644)).To(Succeed())
+}
+
 var _ = Describe("Add resources", func() {
 	var env *TestEnv
 
@@ -154,6 +226,425 @@ var _ = Describe("Add resources", func() {
 		Expect(err).To(Succeed())
 	})
 
+	It("adds helm chart from helm repository", func() {
+		dir, err := os.MkdirTemp("", "helmrepo-")
+		Expect(err).To(Succeed())
+		defer os.RemoveAll(dir)
+		chart, err := loader.LoadDir(filepath.Join("testdata", "testchart"))
+		Expect(err).To(Succeed())
+		_, err = chartutil.Save(chart, dir)
+		Expect(err).To(Succeed())
+		index, err := repo.IndexDirectory(dir, "")
+		Expect(err).To(Succeed())
+		Expect(index.WriteFile(filepath.Join(dir, "index.yaml"), 0o600)).To(Succeed())
+		server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+		defer server.Close()
+
+		Expect(env.Execute("add", "resources", ARCH, "REPO="+server.URL, "/testdata/helmrepo.tmpl")).To(Succeed())
+		data, err := env.ReadFile(env.Join(ARCH, comparch.ComponentDescriptorFileName))
+		Expect(err).To(Succeed())
+		cd, err := compdesc.Decode(data)
+		Expect(err).To(Succeed())
+		Expect(len(cd.Resources)).To(Equal(1))
+
+		r, err := cd.GetResourceByIdentity(metav1.NewIdentity("chart"))
+		Expect(err).To(Succeed())
+		Expect(r.Type).To(Equal(consts.HelmChart))
+		Expect(r.Access.GetType()).To(Equal(localblob.Type))
+
+		acc, err := env.OCMContext().AccessSpecForSpec(r.Access)
+		Expect(err).To(Succeed())
+		Expect(acc.(*localblob.AccessSpec).ReferenceName).To(Equal("test.de/x/testchart:0.1.0"))
+
+		blobpath := env.Join(ARCH, comparch.BlobsDirectoryName, common.DigestToFileName(digest.Digest(acc.(*localblob.AccessSpec).LocalReference)))
+		blob := accessio.BlobAccessForFile(mime.MIME_GZIP, blobpath, env)
+
+		set, err := artefactset.OpenFromBlob(accessobj.ACC_READONLY, blob)
+		Expect(err).To(Succeed())
+		defer set.Close()
+		art, err := set.GetArtefact(set.GetMain().String())
+		Expect(err).To(Succeed())
+		m := art.ManifestAccess().GetDescriptor()
+		Expect(len(m.Layers)).To(Equal(1))
+	})
+
+	It("adds multi-arch image from oci repository", func() {
+		var index *artdesc.Descriptor
+		env.OCICommonTransport("/tmp/ctf", accessio.FormatDirectory, func() {
+			env.Namespace("mandelsoft/test", func() {
+				index = env.Index("v1", func() {
+					env.Manifest("", func() {
+						env.Platform("linux", "amd64")
+						env.Config(func() {
+							env.BlobStringData(mime.MIME_JSON, "{}")
+						})
+						env.Layer(func() {
+							env.BlobStringData(mime.MIME_TEXT, "amd64")
+						})
+					})
+					env.Manifest("", func() {
+						env.Platform("linux", "arm64")
+						env.Config(func() {
+							env.BlobStringData(mime.MIME_JSON, "{}")
+						})
+						env.Layer(func() {
+							env.BlobStringData(mime.MIME_TEXT, "arm64")
+						})
+					})
+				})
+			})
+		})
+
+		Expect(env.Execute("add", "resources", ARCH, "/testdata/ociimage.yaml")).To(Succeed())
+		set := CheckArtefactSetResource(env, "image", index.Digest)
+		defer set.Close()
+		art, err := set.GetArtefact("v1")
+		Expect(err).To(Succeed())
+		defer art.Close()
+		Expect(art.IsIndex()).To(BeTrue())
+		Expect(len(art.IndexAccess().GetDescriptor().Manifests)).To(Equal(2))
+	})
+
+	It("adds multi-arch image from oci layout", func() {
+		amd64 := WriteLayoutManifest(env, "/tmp/layout", "linux", "amd64")
+		arm64 := WriteLayoutManifest(env, "/tmp/layout", "linux", "arm64")
+		idx := artdesc.NewIndex()
+		idx.Manifests = []artdesc.Descriptor{amd64, arm64}
+		data, err := artdesc.EncodeIndex(idx)
+		Expect(err).To(Succeed())
+		index := WriteLayoutBlob(env, "/tmp/layout", artdesc.MediaTypeImageIndex, data)
+		index.Annotations = map[string]string{ociv1.AnnotationRefName: "1.0"}
+
+		other := WriteLayoutManifest(env, "/tmp/layout", "linux", "s390x")
+		other.Annotations = map[string]string{ociv1.AnnotationRefName: "other"}
+
+		WriteLayout(env, "/tmp/layout", index, other)
+
+		Expect(env.Execute("add", "resources", ARCH, "/testdata/ocilayout.yaml")).To(Succeed())
+		set := CheckArtefactSetResource(env, "image", index.Digest)
+		defer set.Close()
+		art, err := set.GetArtefact("1.0")
+		Expect(err).To(Succeed())
+		defer art.Close()
+		Expect(art.IsIndex()).To(BeTrue())
+		Expect(art.Digest()).To(Equal(index.Digest))
+		list := art.IndexAccess().GetDescriptor().Manifests
+		Expect(len(list)).To(Equal(2))
+		Expect(list[1].Digest).To(Equal(arm64.Digest))
+		m, err := art.IndexAccess().GetArtefact(arm64.Digest)
+		Expect(err).To(Succeed())
+		defer m.Close()
+		blob, err := m.ManifestAccess().GetBlob(m.ManifestAccess().GetDescriptor().Layers[0].Digest)
+		Expect(err).To(Succeed())
+		Get(blob, []byte("arm64"))
+	})
+
+	It("rejects oci layout without tag for several artefacts", func() {
+		WriteLayout(env, "/tmp/layout",
+			WriteLayoutManifest(env, "/tmp/layout", "linux", "amd64"),
+			WriteLayoutManifest(env, "/tmp/layout", "linux", "arm64"),
+		)
+		Expect(vfs.WriteFile(env, "/tmp/resources.yaml", []byte(`
+name: image
+type: ociImage
+input:
+  type: ociLayout
+  path: /tmp/layout
+`), 0o644)).To(Succeed())
+
+		err := env.Execute("add", "resources", ARCH, "/tmp/resources.yaml")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("oci layout /tmp/layout contains 2 artefacts: tag required"))
+	})
+
+	Context("multi-platform images", func() {
+		var amd64, arm64 artdesc.Descriptor
+
+		BeforeEach(func() {
+			amd64 = WriteLayoutManifest(env, "/tmp/amd64", "linux", "amd64")
+			amd64.Platform = nil
+			WriteLayout(env, "/tmp/amd64", amd64)
+			arm64 = WriteLayoutManifest(env, "/tmp/arm64", "linux", "arm64")
+			arm64.Annotations = map[string]string{ociv1.AnnotationRefName: "arm"}
+			WriteLayout(env, "/tmp/arm64", arm64)
+		})
+
+		It("combines images from oci layouts", func() {
+			Expect(vfs.WriteFile(env, "/tmp/resources.yaml", []byte(`
+name: image
+type: ociImage
+input:
+  type: dockerMulti
+  variants:
+  - path: /tmp/amd64
+  - path: /tmp/arm64
+    tag: arm
+    platform: linux/arm64/v8
+`), 0o644)).To(Succeed())
+
+			Expect(env.Execute("add", "resources", ARCH, "/tmp/resources.yaml")).To(Succeed())
+
+			data, err := env.ReadFile(env.Join(ARCH, comparch.ComponentDescriptorFileName))
+			Expect(err).To(Succeed())
+			cd, err := compdesc.Decode(data)
+			Expect(err).To(Succeed())
+			r, err := cd.GetResourceByIdentity(metav1.NewIdentity("image"))
+			Expect(err).To(Succeed())
+			acc, err := env.OCMContext().AccessSpecForSpec(r.Access)
+			Expect(err).To(Succeed())
+			Expect(acc.(*localblob.AccessSpec).ReferenceName).To(Equal("test.de/x:" + VERSION))
+
+			blobpath := env.Join(ARCH, comparch.BlobsDirectoryName, common.DigestToFileName(digest.Digest(acc.(*localblob.AccessSpec).LocalReference)))
+			set, err := artefactset.OpenFromBlob(accessobj.ACC_READONLY, accessio.BlobAccessForFile(mime.MIME_GZIP, blobpath, env))
+			Expect(err).To(Succeed())
+			defer set.Close()
+			art, err := set.GetArtefact(VERSION)
+			Expect(err).To(Succeed())
+			defer art.Close()
+			Expect(art.IsIndex()).To(BeTrue())
+			Expect(art.Digest()).To(Equal(set.GetMain()))
+			list := art.IndexAccess().GetDescriptor().Manifests
+			Expect(len(list)).To(Equal(2))
+			Expect(list[0].Digest).To(Equal(amd64.Digest))
+			Expect(list[0].Platform).To(Equal(&artdesc.Platform{OS: "linux", Architecture: "amd64"}))
+			Expect(list[0].Annotations).To(BeNil())
+			Expect(list[1].Digest).To(Equal(arm64.Digest))
+			Expect(list[1].Platform).To(Equal(&artdesc.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}))
+
+			m, err := art.IndexAccess().GetArtefact(arm64.Digest)
+			Expect(err).To(Succeed())
+			defer m.Close()
+			blob, err := m.ManifestAccess().GetBlob(m.ManifestAccess().GetDescriptor().Layers[0].Digest)
+			Expect(err).To(Succeed())
+			Get(blob, []byte("arm64"))
+		})
+
+		It("rejects duplicate platforms", func() {
+			Expect(vfs.WriteFile(env, "/tmp/resources.yaml", []byte(`
+name: image
+type: ociImage
+input:
+  type: dockerMulti
+  variants:
+  - path: /tmp/amd64
+  - path: /tmp/arm64
+    platform: linux/amd64
+`), 0o644)).To(Succeed())
+
+			err := env.Execute("add", "resources", ARCH, "/tmp/resources.yaml")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("variant 2: duplicate platform linux/amd64"))
+		})
+
+		It("rejects invalid variants", func() {
+			Expect(vfs.WriteFile(env, "/tmp/resources.yaml", []byte(`
+name: image
+type: ociImage
+input:
+  type: dockerMulti
+  variants:
+  - image: test:v1
+    path: /tmp/amd64
+  - platform: linux
+`), 0o644)).To(Succeed())
+
+			err := env.Execute("add", "resources", ARCH, "/tmp/resources.yaml")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("input.variants[0]: Invalid value: \"test:v1\": only one of image or path may be specified"))
+			Expect(err.Error()).To(ContainSubstring("input.variants[1]: Required value: image or path is required"))
+			Expect(err.Error()).To(ContainSubstring("input.variants[1].platform: Invalid value: \"linux\""))
+		})
+	})
+
+	Context("wget", func() {
+		var server *httptest.Server
+		var content, compressed []byte
+
+		BeforeEach(func() {
+			content = []byte("some binary content")
+			var buf bytes.Buffer
+			gw := gzip.NewWriter(&buf)
+			_, err := gw.Write(content)
+			Expect(err).To(Succeed())
+			Expect(gw.Close()).To(Succeed())
+			compressed = buf.Bytes()
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				switch r.URL.Path {
+				case "/bin/tool":
+					w.Write(content)
+				case "/bin/tool.gz":
+					w.Write(compressed)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			u, err := url.Parse(server.URL)
+			Expect(err).To(Succeed())
+			env.CredentialsContext().SetCredentialsForConsumer(credentials.ConsumerIdentity{
+				credentials.CONSUMER_ATTR_TYPE: httpidentity.CONSUMER_TYPE,
+				identity.ID_HOSTNAME:           u.Hostname(),
+				identity.ID_PORT:               u.Port(),
+			}, credentials.NewCredentials(common.Properties{
+				credentials.ATTR_USERNAME: "user",
+				credentials.ATTR_PASSWORD: "pass",
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		WriteResources := func(path string, dig digest.Digest, decompress bool) {
+			Expect(vfs.WriteFile(env, "/tmp/resources.yaml", []byte(fmt.Sprintf(`
+name: tool
+type: executable
+input:
+  type: wget
+  url: %s%s
+  digest: %s
+  decompress: %t
+`, server.URL, path, dig, decompress)), 0o644)).To(Succeed())
+		}
+
+		CheckContent := func(expected []byte, mediaType string) {
+			data, err := env.ReadFile(env.Join(ARCH, comparch.ComponentDescriptorFileName))
+			Expect(err).To(Succeed())
+			cd, err := compdesc.Decode(data)
+			Expect(err).To(Succeed())
+			r, err := cd.GetResourceByIdentity(metav1.NewIdentity("tool"))
+			Expect(err).To(Succeed())
+			acc, err := env.OCMContext().AccessSpecForSpec(r.Access)
+			Expect(err).To(Succeed())
+			Expect(acc.(*localblob.AccessSpec).MediaType).To(Equal(mediaType))
+			Expect(acc.(*localblob.AccessSpec).LocalReference).To(Equal(common.DigestToFileName(digest.FromBytes(expected))))
+			Expect(env.ReadFile(env.Join(ARCH, comparch.BlobsDirectoryName, acc.(*localblob.AccessSpec).LocalReference))).To(Equal(expected))
+		}
+
+		It("downloads content", func() {
+			WriteResources("/bin/tool", digest.FromBytes(content), false)
+			Expect(env.Execute("add", "resources", ARCH, "/tmp/resources.yaml")).To(Succeed())
+			CheckContent(content, mime.MIME_OCTET)
+		})
+
+		It("downloads and decompresses content", func() {
+			WriteResources("/bin/tool.gz", digest.SHA512.FromBytes(compressed), true)
+			Expect(env.Execute("add", "resources", ARCH, "/tmp/resources.yaml")).To(Succeed())
+			CheckContent(content, mime.MIME_OCTET)
+		})
+
+		It("rejects digest mismatch", func() {
+			WriteResources("/bin/tool.gz", digest.FromBytes(content), false)
+			err := env.Execute("add", "resources", ARCH, "/tmp/resources.yaml")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("digest mismatch for %s/bin/tool.gz: expected %s, found %s", server.URL, digest.FromBytes(content), digest.FromBytes(compressed))))
+		})
+
+		It("rejects unsupported digest algorithm", func() {
+			WriteResources("/bin/tool", digest.Digest("sha384:"+digest.SHA384.FromBytes(content).Encoded()), false)
+			err := env.Execute("add", "resources", ARCH, "/tmp/resources.yaml")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`input.digest: Unsupported value: "sha384"`))
+		})
+	})
+
+	Context("reproducible directories", func() {
+		BeforeEach(func() {
+			Expect(env.MkdirAll("/tmp/content/bin", 0o700)).To(Succeed())
+			Expect(vfs.WriteFile(env, "/tmp/content/bin/tool", []byte("#!/bin/sh"), 0o750)).To(Succeed())
+			Expect(vfs.WriteFile(env, "/tmp/content/data", []byte("data"), 0o600)).To(Succeed())
+		})
+
+		WriteResources := func(reproducible string) {
+			Expect(vfs.WriteFile(env, "/tmp/resources.yaml", []byte(`
+name: content
+type: blob
+input:
+  type: dir
+  path: content
+  compress: true
+`+reproducible), 0o644)).To(Succeed())
+		}
+
+		AddContent := func() (string, []*tar.Header) {
+			Expect(env.Execute("create", "ca", "-f", "-ft", "directory", "test.de/x", VERSION, "mandelsoft", ARCH)).To(Succeed())
+			Expect(env.Execute("add", "resources", ARCH, "/tmp/resources.yaml")).To(Succeed())
+			data, err := env.ReadFile(env.Join(ARCH, comparch.ComponentDescriptorFileName))
+			Expect(err).To(Succeed())
+			cd, err := compdesc.Decode(data)
+			Expect(err).To(Succeed())
+			r, err := cd.GetResourceByIdentity(metav1.NewIdentity("content"))
+			Expect(err).To(Succeed())
+			acc, err := env.OCMContext().AccessSpecForSpec(r.Access)
+			Expect(err).To(Succeed())
+			local := acc.(*localblob.AccessSpec).LocalReference
+
+			file, err := env.Open(env.Join(ARCH, comparch.BlobsDirectoryName, local))
+			Expect(err).To(Succeed())
+			defer file.Close()
+			gr, err := gzip.NewReader(file)
+			Expect(err).To(Succeed())
+			tr := tar.NewReader(gr)
+			var headers []*tar.Header
+			for {
+				h, err := tr.Next()
+				if err != nil {
+					Expect(err).To(Equal(io.EOF))
+					break
+				}
+				headers = append(headers, h)
+			}
+			return local, headers
+		}
+
+		CheckHeaders := func(headers []*tar.Header) {
+			modes := map[string]int64{}
+			for _, h := range headers {
+				Expect(h.ModTime.Equal(time.Unix(0, 0))).To(BeTrue())
+				Expect(h.Uid).To(Equal(0))
+				Expect(h.Gid).To(Equal(0))
+				Expect(h.Uname).To(Equal(""))
+				Expect(h.Gname).To(Equal(""))
+				modes[h.Name] = h.Mode
+			}
+			Expect(modes).To(Equal(map[string]int64{
+				"bin":      0o755,
+				"bin/tool": 0o755,
+				"data":     0o644,
+			}))
+		}
+
+		It("normalizes archive by input spec", func() {
+			WriteResources("  reproducible: true\n")
+			first, headers := AddContent()
+			CheckHeaders(headers)
+
+			Expect(env.Chtimes("/tmp/content/data", time.Now(), time.Now().Add(time.Hour))).To(Succeed())
+			Expect(env.Chmod("/tmp/content/data", 0o640)).To(Succeed())
+			second, _ := AddContent()
+			Expect(second).To(Equal(first))
+		})
+
+		It("normalizes archive by attribute", func() {
+			Expect(reproducibleattr.Set(env.OCMContext(), true)).To(Succeed())
+			WriteResources("")
+			_, headers := AddContent()
+			CheckHeaders(headers)
+		})
+
+		It("keeps host information if disabled", func() {
+			Expect(reproducibleattr.Set(env.OCMContext(), true)).To(Succeed())
+			WriteResources("  reproducible: false\n")
+			_, headers := AddContent()
+			Expect(headers[2].Name).To(Equal("data"))
+			Expect(headers[2].Mode).To(Equal(int64(0o600)))
+		})
+	})
+
 	It("adds external image", func() {
 		Expect(env.Execute("add", "resources", ARCH, "/testdata/image.yaml")).To(Succeed())
 		data, err := env.ReadFile(env.Join(ARCH, comparch.ComponentDescriptorFileName))
@@ -175,4 +666,31 @@ var _ = Describe("Add resources", func() {
 		Expect(reflect.TypeOf(acc)).To(Equal(reflect.TypeOf((*ociartefact.AccessSpec)(nil))))
 		Expect(acc.(*ociartefact.AccessSpec).ImageReference).To(Equal("ghcr.io/mandelsoft/pause:v0.1.0"))
 	})
+	It("adds external http resource by command line options", func() {
+		dig := digest.FromString("testdata").String()
+		Expect(env.Execute("add", "resources", ARCH, "--accessType", "http", "--name", "data", "--type", "blob", "--version", "v1",
+			"--url", "https://example.com/data", "--mediaType", mime.MIME_TEXT, "--digest", dig)).To(Succeed())
+		data, err := env.ReadFile(env.Join(ARCH, comparch.ComponentDescriptorFileName))
+		Expect(err).To(Succeed())
+		cd, err := compdesc.Decode(data)
+		Expect(err).To(Succeed())
+		Expect(len(cd.Resources)).To(Equal(1))
+
+		r, err := cd.GetResourceByIdentity(metav1.NewIdentity("data"))
+		Expect(err).To(Succeed())
+		Expect(r.Type).To(Equal("blob"))
+		Expect(r.Version).To(Equal("v1"))
+		Expect(r.Relation).To(Equal(metav1.ResourceRelation("external")))
+
+		Expect(r.Access.GetType()).To(Equal(httpaccess.Type))
+
+		acc, err := env.OCMContext().AccessSpecForSpec(r.Access)
+		Expect(err).To(Succeed())
+		Expect(acc).To(Equal(httpaccess.New("https://example.com/data", mime.MIME_TEXT, dig)))
+	})
+
+	It("rejects access options without url", func() {
+		Expect(env.Execute("add", "resources", ARCH, "--accessType", "http", "--name", "data", "--type", "blob")).To(
+			MatchError("option --url required for access type http"))
+	})
 })
@@ -69,7 +69,7 @@ func (ResourceSpecHandler) Set(v ocm.ComponentVersionAccess, r common.Resource,
 			Name:          spec.Name,
 			Version:       vers,
 			ExtraIdentity: spec.ExtraIdentity,
-			Labels:        spec.Labels,
+			Labels:        r.Labels(spec.Labels),
 		},
 		Type:      spec.Type,
 		Relation:  spec.Relation,
@@ -17,13 +17,16 @@ package add_test
 import (
 	"archive/tar"
 	"compress/gzip"
+	"fmt"
 	"io"
+	"os"
 	"sort"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
 
+	"github.com/mandelsoft/vfs/pkg/vfs"
 	"github.com/sirupsen/logrus"
 
 	"github.com/open-component-model/ocm/pkg/common"
@@ -32,6 +35,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
 	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/comparch"
+	"github.com/open-component-model/ocm/pkg/git/testhelper"
 	"github.com/open-component-model/ocm/pkg/mime"
 )
 
@@ -145,4 +149,134 @@ var _ = Describe("Add sources", func() {
 
 		CheckTextSource(env, cd, "testdata")
 	})
+
+	Context("git", func() {
+		var dir string
+		var hashes []string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "gitinput-*")
+			Expect(err).To(Succeed())
+			hashes, err = testhelper.CreateBareRepository(dir,
+				testhelper.Commit{
+					".gitignore":  "*.log\nbuild/\n",
+					"README.md":   "readme",
+					"src/main.go": "package main",
+				},
+				testhelper.Commit{
+					"src/main.go": "package main\n\nfunc main() {}",
+					"debug.log":   "ignored",
+				},
+			)
+			Expect(err).To(Succeed())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		WriteSources := func(spec string) {
+			Expect(vfs.WriteFile(env, "/tmp/sources.yaml", []byte(fmt.Sprintf(`
+name: repo
+type: git
+version: v1
+input:
+  type: git
+  repository: file://%s
+%s
+`, dir, spec)), 0o644)).To(Succeed())
+		}
+
+		GetSource := func() (compdesc.Source, *localblob.AccessSpec) {
+			data, err := env.ReadFile(env.Join(ARCH, comparch.ComponentDescriptorFileName))
+			Expect(err).To(Succeed())
+			cd, err := compdesc.Decode(data)
+			Expect(err).To(Succeed())
+			r, err := cd.GetSourceByIdentity(metav1.NewIdentity("repo"))
+			Expect(err).To(Succeed())
+			spec, err := env.OCMContext().AccessSpecForSpec(r.Access)
+			Expect(err).To(Succeed())
+			return r, spec.(*localblob.AccessSpec)
+		}
+
+		ListFiles := func(acc *localblob.AccessSpec) map[string]string {
+			file, err := env.Open(env.Join(ARCH, comparch.BlobsDirectoryName, acc.LocalReference))
+			Expect(err).To(Succeed())
+			defer file.Close()
+			tr := tar.NewReader(file)
+			files := map[string]string{}
+			for {
+				header, err := tr.Next()
+				if err != nil {
+					if err == io.EOF {
+						break
+					}
+					Expect(err).To(Succeed())
+				}
+				if header.Typeflag == tar.TypeReg {
+					data, err := io.ReadAll(tr)
+					Expect(err).To(Succeed())
+					files[header.Name] = string(data)
+				}
+			}
+			return files
+		}
+
+		It("adds head of repository", func() {
+			WriteSources("")
+			Expect(env.Execute("add", "sources", ARCH, "/tmp/sources.yaml")).To(Succeed())
+			r, acc := GetSource()
+			Expect(acc.MediaType).To(Equal(mime.MIME_TAR))
+			Expect(r.Labels).To(Equal(metav1.Labels{{Name: "commit", Value: []byte(`"` + hashes[1] + `"`)}}))
+			Expect(ListFiles(acc)).To(Equal(map[string]string{
+				".gitignore":  "*.log\nbuild/\n",
+				"README.md":   "readme",
+				"src/main.go": "package main\n\nfunc main() {}",
+			}))
+		})
+
+		It("adds tagged commit with patterns", func() {
+			WriteSources(`  ref: v1
+  excludeFiles:
+  - README.md`)
+			Expect(env.Execute("add", "sources", ARCH, "/tmp/sources.yaml")).To(Succeed())
+			r, acc := GetSource()
+			Expect(r.Labels).To(Equal(metav1.Labels{{Name: "commit", Value: []byte(`"` + hashes[0] + `"`)}}))
+			Expect(ListFiles(acc)).To(Equal(map[string]string{
+				".gitignore":  "*.log\nbuild/\n",
+				"src/main.go": "package main",
+			}))
+		})
+
+		It("keeps explicit commit label", func() {
+			Expect(vfs.WriteFile(env, "/tmp/sources.yaml", []byte(fmt.Sprintf(`
+name: repo
+type: git
+version: v1
+labels:
+- name: commit
+  value: other
+input:
+  type: git
+  repository: %s
+`, dir)), 0o644)).To(Succeed())
+			Expect(env.Execute("add", "sources", ARCH, "/tmp/sources.yaml")).To(Succeed())
+			r, _ := GetSource()
+			Expect(r.Labels).To(Equal(metav1.Labels{{Name: "commit", Value: []byte(`"other"`)}}))
+		})
+
+		It("creates reproducible compressed archives", func() {
+			WriteSources("  compress: true")
+			Expect(env.Execute("add", "sources", ARCH, "/tmp/sources.yaml")).To(Succeed())
+			_, acc := GetSource()
+			Expect(acc.MediaType).To(Equal(mime.MIME_TGZ))
+			first := acc.LocalReference
+
+			Expect(env.Execute("create", "ca", "-f", "-ft", "directory", "test.de/x", VERSION, "mandelsoft", ARCH)).To(Succeed())
+			Expect(env.Execute("add", "sources", ARCH, "/tmp/sources.yaml")).To(Succeed())
+			_, acc = GetSource()
+			Expect(acc.LocalReference).To(Equal(first))
+		})
+	})
 })
@@ -35,7 +35,7 @@ const PROVIDER = "mandelsoft"
 var _ = Describe("Test Environment", func() {
 	var env *TestEnv
 
-	spec, err := ocm.NewGenericAccessSpec("{\"type\":\"git\"}")
+	spec, err := ocm.NewGenericAccessSpec("{\"type\":\"git\",\"repoUrl\":\"https://github.com/open-component-model/ocm\"}")
 	Expect(err).To(Succeed())
 
 	BeforeEach(func() {
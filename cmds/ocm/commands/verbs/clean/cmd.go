@@ -18,6 +18,7 @@ import (
 	"github.com/spf13/cobra"
 
 	cache "github.com/open-component-model/ocm/cmds/ocm/commands/cachecmds/clean"
+	ctf "github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/ctf/clean"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
@@ -29,5 +30,6 @@ func NewCommand(ctx clictx.Context) *cobra.Command {
 		Short: "Cleanup/re-organize elements",
 	}, verbs.Clean)
 	cmd.AddCommand(cache.NewCommand(ctx))
+	cmd.AddCommand(ctf.NewCommand(ctx))
 	return cmd
 }
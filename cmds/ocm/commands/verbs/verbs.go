@@ -19,6 +19,7 @@ const (
 	Describe  = "describe"
 	Add       = "add"
 	Create    = "create"
+	Delete    = "delete"
 	Transfer  = "transfer"
 	Download  = "download"
 	Bootstrap = "bootstrap"
@@ -27,4 +28,7 @@ const (
 	Verify    = "verify"
 	Clean     = "clean"
 	Info      = "info"
+	Diff      = "diff"
+	Validate  = "validate"
+	Tag       = "tag"
 )
@@ -7,3 +7,23 @@ import "io"
 type Downloader interface {
 	Download(w io.WriterAt) error
 }
+
+// Buffer is an in-memory WriterAt, which can be used to
+// download content into memory.
+type Buffer struct {
+	data []byte
+}
+
+var _ io.WriterAt = (*Buffer)(nil)
+
+func (b *Buffer) WriteAt(p []byte, off int64) (int, error) {
+	if end := int(off) + len(p); end > len(b.data) {
+		b.data = append(b.data, make([]byte, end-len(b.data))...)
+	}
+	return copy(b.data[off:], p), nil
+}
+
+// Bytes returns the downloaded content.
+func (b *Buffer) Bytes() []byte {
+	return b.data
+}
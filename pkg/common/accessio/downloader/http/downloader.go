@@ -3,33 +3,70 @@ package http
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/open-component-model/ocm/pkg/errors"
 )
 
 // Downloader simply uses the default HTTP client to download the contents of a URL.
 type Downloader struct {
-	link string
+	link   string
+	header http.Header
 }
 
-func NewDownloader(link string) *Downloader {
-	return &Downloader{
-		link: link,
+// Option defines an option for a Downloader.
+type Option func(d *Downloader)
+
+// WithBasicAuth uses basic authentication for the download.
+func WithBasicAuth(user, password string) Option {
+	return func(d *Downloader) {
+		auth := base64.StdEncoding.EncodeToString([]byte(user + ":" + password))
+		d.header.Set("Authorization", "Basic "+auth)
 	}
 }
 
+// WithToken uses a bearer token for the download.
+func WithToken(token string) Option {
+	return func(d *Downloader) {
+		d.header.Set("Authorization", "Bearer "+token)
+	}
+}
+
+func NewDownloader(link string, opts ...Option) *Downloader {
+	d := &Downloader{
+		link:   link,
+		header: http.Header{},
+	}
+	for _, o := range opts {
+		o(d)
+	}
+	return d
+}
+
 func (h *Downloader) Download(w io.WriterAt) error {
 	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, h.link, nil)
 	if err != nil {
 		return err
 	}
 
+	for k, v := range h.header {
+		req.Header[k] = v
+	}
+
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to get link: %w", err)
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return errors.ErrNotFoundWrap(fmt.Errorf("failed to get link %s: %s", h.link, resp.Status), "url", h.link)
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to get link %s: %s", h.link, resp.Status)
+	}
 
 	var blob []byte
 	buf := bytes.NewBuffer(blob)
@@ -0,0 +1,62 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// Uploader is capable of uploading S3 Objects.
+type Uploader struct {
+	region, bucket, key string
+	endpoint            string
+	creds               *AWSCreds
+}
+
+func NewUploader(region, bucket, key string, creds *AWSCreds) *Uploader {
+	return &Uploader{
+		region: region,
+		bucket: bucket,
+		key:    key,
+		creds:  creds,
+	}
+}
+
+// WithEndpoint sets the endpoint of an S3 compatible store
+// to use instead of AWS.
+func (s *Uploader) WithEndpoint(endpoint string) *Uploader {
+	s.endpoint = endpoint
+	return s
+}
+
+// Upload stores the content of the given reader with the given media type.
+// It returns the version of the object, if the bucket is versioned.
+func (s *Uploader) Upload(r io.Reader, mediaType string) (string, error) {
+	ctx := context.Background()
+	client, err := newClient(ctx, s.region, s.bucket, s.endpoint, s.creds)
+	if err != nil {
+		return "", err
+	}
+	uploader := manager.NewUploader(client)
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key),
+		Body:   r,
+	}
+	if mediaType != "" {
+		input.ContentType = aws.String(mediaType)
+	}
+	out, err := uploader.Upload(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload object: %w", err)
+	}
+	if out.VersionID != nil {
+		return *out.VersionID, nil
+	}
+	return "", nil
+}
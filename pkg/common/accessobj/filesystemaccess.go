@@ -144,3 +144,22 @@ func (a *FileSystemBlobAccess) AddBlob(blob accessio.BlobAccess) error {
 	}
 	return w.Close()
 }
+
+// RemoveBlob removes the blob file for the given digest.
+// It is not an error if the blob does not exist.
+func (a *FileSystemBlobAccess) RemoveBlob(digest digest.Digest) error {
+	if a.base.IsClosed() {
+		return accessio.ErrClosed
+	}
+
+	if a.base.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+
+	path := a.DigestPath(digest)
+	err := a.base.GetFileSystem().Remove(path)
+	if err != nil && !vfs.IsErrNotExist(err) {
+		return fmt.Errorf("unable to remove file '%s': %w", path, err)
+	}
+	return nil
+}
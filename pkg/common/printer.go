@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 )
 
 type Printer interface {
@@ -28,6 +29,7 @@ type Printer interface {
 }
 
 type printerState struct {
+	lock    sync.Mutex
 	pending bool
 }
 
@@ -38,7 +40,7 @@ type printer struct {
 }
 
 func NewPrinter(writer io.Writer) Printer {
-	return &printer{writer: writer, state: &printerState{true}}
+	return &printer{writer: writer, state: &printerState{pending: true}}
 }
 
 func (p *printer) AddGap(gap string) Printer {
@@ -50,6 +52,12 @@ func (p *printer) AddGap(gap string) Printer {
 }
 
 func (p *printer) Write(data []byte) (int, error) {
+	p.state.lock.Lock()
+	defer p.state.lock.Unlock()
+	return p.write(data)
+}
+
+func (p *printer) write(data []byte) (int, error) {
 	if p.writer == nil {
 		return 0, nil
 	}
@@ -73,9 +81,11 @@ func (p *printer) printf(msg string, args ...interface{}) (int, error) {
 	}
 	data := fmt.Sprintf(msg, args...)
 	p.state.pending = false
-	return p.Write([]byte(data))
+	return p.write([]byte(data))
 }
 
 func (p *printer) Printf(msg string, args ...interface{}) (int, error) {
+	p.state.lock.Lock()
+	defer p.state.lock.Unlock()
 	return p.printf(msg, args...)
 }
@@ -29,4 +29,5 @@ const (
 	ATTR_TOKEN                 = core.ATTR_TOKEN
 	ATTR_AWS_ACCESS_KEY_ID     = core.ATTR_AWS_ACCESS_KEY_ID
 	ATTR_AWS_SECRET_ACCESS_KEY = core.ATTR_AWS_SECRET_ACCESS_KEY
+	ATTR_PRIVATE_KEY           = core.ATTR_PRIVATE_KEY
 )
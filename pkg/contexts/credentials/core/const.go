@@ -26,4 +26,5 @@ const (
 	ATTR_AWS_ACCESS_KEY_ID     = "awsAccessKeyID"
 	ATTR_AWS_SECRET_ACCESS_KEY = "awsSecretAccessKey"
 	ATTR_KEY                   = "key"
+	ATTR_PRIVATE_KEY           = "privateKey"
 )
@@ -217,4 +217,30 @@ var _ = Describe("ctf management", func() {
 		})
 	})
 
+	Context("url", func() {
+		It("provides identity for url", func() {
+			id, err := hostpath.ConsumerIdentityForURL("test", "https://host:4711/a/b/")
+			Expect(err).To(Succeed())
+			Expect(id).To(Equal(core.ConsumerIdentity{
+				core.CONSUMER_ATTR_TYPE: "test",
+				hostpath.ID_HOSTNAME:    "host",
+				hostpath.ID_PORT:        "4711",
+				hostpath.ID_PATHPREFIX:  "a/b",
+			}))
+		})
+
+		It("omits empty port and path", func() {
+			id, err := hostpath.ConsumerIdentityForURL("test", "https://host")
+			Expect(err).To(Succeed())
+			Expect(id).To(Equal(core.ConsumerIdentity{
+				core.CONSUMER_ATTR_TYPE: "test",
+				hostpath.ID_HOSTNAME:    "host",
+			}))
+		})
+
+		It("rejects invalid url", func() {
+			_, err := hostpath.ConsumerIdentityForURL("test", "https://host:port")
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -15,10 +15,12 @@
 package hostpath
 
 import (
+	"net/url"
 	"strings"
 
 	"github.com/open-component-model/ocm/pkg/contexts/credentials/core"
 	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
 )
 
 // IDENTITY_TYPE is the identity of this matcher.
@@ -112,3 +114,46 @@ func IdentityMatcher(identityType string) cpi.IdentityMatcher {
 		return false
 	}
 }
+
+// ConsumerIdentity returns the consumer identity of the given consumer type
+// for a host with an optional port and path.
+func ConsumerIdentity(consumerType, host, port, path string) core.ConsumerIdentity {
+	id := core.ConsumerIdentity{
+		core.CONSUMER_ATTR_TYPE: consumerType,
+		ID_HOSTNAME:             host,
+	}
+	if port != "" {
+		id[ID_PORT] = port
+	}
+	if path = strings.Trim(path, "/"); path != "" {
+		id[ID_PATHPREFIX] = path
+	}
+	return id
+}
+
+// ConsumerIdentityForURL returns the consumer identity of the given
+// consumer type for the host, port and path of a URL.
+func ConsumerIdentityForURL(consumerType, rawurl string) (core.ConsumerIdentity, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, errors.ErrInvalidWrap(err, "url", rawurl)
+	}
+	return ConsumerIdentity(consumerType, u.Hostname(), u.Port(), u.Path), nil
+}
+
+// GetCredentials returns the credentials configured for the given
+// consumer identity using the host and path based matcher for
+// its consumer type. If no credentials are configured, nil is returned.
+func GetCredentials(cctx core.Context, id core.ConsumerIdentity) (core.Credentials, error) {
+	src, err := cctx.GetCredentialsForConsumer(id, IdentityMatcher(id[core.CONSUMER_ATTR_TYPE]))
+	if err != nil {
+		if errors.IsErrUnknown(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if src == nil {
+		return nil, nil
+	}
+	return src.Credentials(cctx)
+}
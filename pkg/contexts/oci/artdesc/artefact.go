@@ -127,6 +127,45 @@ func (d *Artefact) Manifest() *Manifest {
 	return d.manifest
 }
 
+// Subject returns the descriptor of the artefact referred to by this one,
+// or nil, if the artefact does not refer to another artefact.
+func (d *Artefact) Subject() *Descriptor {
+	if d.IsManifest() {
+		return d.manifest.Subject
+	}
+	if d.IsIndex() {
+		return d.index.Subject
+	}
+	return nil
+}
+
+// ArtifactType returns the artifact type of the artefact.
+// For manifests without explicit artifact type the media type
+// of the config is used.
+func (d *Artefact) ArtifactType() string {
+	if d.IsManifest() {
+		if d.manifest.ArtifactType != "" {
+			return d.manifest.ArtifactType
+		}
+		return d.manifest.Config.MediaType
+	}
+	if d.IsIndex() {
+		return d.index.ArtifactType
+	}
+	return ""
+}
+
+// Annotations returns the annotations of the artefact.
+func (d *Artefact) Annotations() map[string]string {
+	if d.IsManifest() {
+		return d.manifest.Annotations
+	}
+	if d.IsIndex() {
+		return d.index.Annotations
+	}
+	return nil
+}
+
 func (d *Artefact) ToBlobAccess() (accessio.BlobAccess, error) {
 	if d.IsManifest() {
 		return d.manifest.ToBlobAccess()
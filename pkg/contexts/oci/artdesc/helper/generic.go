@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/containerd/containerd/images"
+	"github.com/opencontainers/image-spec/specs-go"
 	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
 
 	"github.com/open-component-model/ocm/pkg/errors"
@@ -25,8 +26,54 @@ import (
 
 const SchemeVersion = 2
 
+// Manifest is the OCI image manifest extended by the fields
+// artifactType and subject introduced with version 1.1 of the image spec.
+type Manifest struct {
+	specs.Versioned
+
+	// MediaType specifies the type of this document data structure e.g. `application/vnd.oci.image.manifest.v1+json`
+	MediaType string `json:"mediaType,omitempty"`
+
+	// ArtifactType specifies the IANA media type of an artifact described by the manifest.
+	ArtifactType string `json:"artifactType,omitempty"`
+
+	// Config references a configuration object for a container, by digest.
+	// The referenced configuration object is a JSON blob that the runtime uses to set up the container.
+	Config ociv1.Descriptor `json:"config"`
+
+	// Layers is an indexed list of layers referenced by the manifest.
+	Layers []ociv1.Descriptor `json:"layers"`
+
+	// Subject is an optional link to another manifest, this manifest refers to.
+	Subject *ociv1.Descriptor `json:"subject,omitempty"`
+
+	// Annotations contains arbitrary metadata for the image manifest.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Index is the OCI image index extended by the fields
+// artifactType and subject introduced with version 1.1 of the image spec.
+type Index struct {
+	specs.Versioned
+
+	// MediaType specifies the type of this document data structure e.g. `application/vnd.oci.image.index.v1+json`
+	MediaType string `json:"mediaType,omitempty"`
+
+	// ArtifactType specifies the IANA media type of an artifact described by the index.
+	ArtifactType string `json:"artifactType,omitempty"`
+
+	// Manifests references platform specific manifests.
+	Manifests []ociv1.Descriptor `json:"manifests"`
+
+	// Subject is an optional link to another manifest, this index refers to.
+	Subject *ociv1.Descriptor `json:"subject,omitempty"`
+
+	// Annotations contains arbitrary metadata for the image index.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
 type GenericDescriptor struct {
-	ociv1.Manifest
+	Manifest
 	// Manifests references platform specific manifests.
 	Manifests []ociv1.Descriptor `json:"manifests"`
 }
@@ -57,21 +104,18 @@ func (g *GenericDescriptor) IsManifest() bool {
 	return g.MediaType == ociv1.MediaTypeImageManifest || len(g.Layers) > 0
 }
 
-func (g *GenericDescriptor) AsManifest() *ociv1.Manifest {
-	return &ociv1.Manifest{
-		Versioned:   g.Versioned,
-		MediaType:   g.MediaType,
-		Config:      g.Config,
-		Layers:      g.Layers,
-		Annotations: g.Annotations,
-	}
+func (g *GenericDescriptor) AsManifest() *Manifest {
+	m := g.Manifest
+	return &m
 }
 
-func (g *GenericDescriptor) AsIndex() *ociv1.Index {
-	return &ociv1.Index{
-		Versioned:   g.Versioned,
-		MediaType:   g.MediaType,
-		Manifests:   g.Manifests,
-		Annotations: g.Annotations,
+func (g *GenericDescriptor) AsIndex() *Index {
+	return &Index{
+		Versioned:    g.Versioned,
+		MediaType:    g.MediaType,
+		ArtifactType: g.ArtifactType,
+		Manifests:    g.Manifests,
+		Subject:      g.Subject,
+		Annotations:  g.Annotations,
 	}
 }
@@ -19,12 +19,12 @@ import (
 
 	"github.com/opencontainers/go-digest"
 	"github.com/opencontainers/image-spec/specs-go"
-	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
 
 	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc/helper"
 )
 
-type Manifest ociv1.Manifest
+type Manifest helper.Manifest
 
 var _ BlobDescriptorSource = (*Manifest)(nil)
 
@@ -0,0 +1,90 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package artdesc
+
+import (
+	"encoding/json"
+	"strings"
+
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// ParsePlatform parses a platform given as <os>/<architecture>[/<variant>].
+func ParsePlatform(s string) (*Platform, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, errors.ErrInvalid("platform", s)
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, errors.ErrInvalid("platform", s)
+		}
+	}
+	p := &Platform{
+		OS:           parts[0],
+		Architecture: parts[1],
+	}
+	if len(parts) == 3 {
+		p.Variant = parts[2]
+	}
+	return p, nil
+}
+
+// PlatformString returns the string representation of a platform
+// as accepted by ParsePlatform.
+func PlatformString(p *Platform) string {
+	if p == nil {
+		return ""
+	}
+	s := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		s += "/" + p.Variant
+	}
+	return s
+}
+
+// MatchPlatform checks whether the given platform matches one
+// of the given filters. A filter without variant matches all variants.
+func MatchPlatform(p *Platform, filters ...*Platform) bool {
+	if p == nil {
+		return false
+	}
+	for _, f := range filters {
+		if f.OS == p.OS && f.Architecture == p.Architecture && (f.Variant == "" || f.Variant == p.Variant) {
+			return true
+		}
+	}
+	return false
+}
+
+// PlatformForConfig determines the platform described by
+// an image config.
+func PlatformForConfig(data []byte) (*Platform, error) {
+	var cfg ociv1.Image
+	err := json.Unmarshal(data, &cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid image config")
+	}
+	if cfg.OS == "" || cfg.Architecture == "" {
+		return nil, errors.Newf("image config describes no platform")
+	}
+	return &Platform{
+		OS:           cfg.OS,
+		Architecture: cfg.Architecture,
+		Variant:      cfg.Variant,
+	}, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package artdesc_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+)
+
+var _ = Describe("platform", func() {
+
+	It("parses platforms", func() {
+		p, err := artdesc.ParsePlatform("linux/arm64/v8")
+		Expect(err).To(Succeed())
+		Expect(p).To(Equal(&artdesc.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}))
+		Expect(artdesc.PlatformString(p)).To(Equal("linux/arm64/v8"))
+
+		p, err = artdesc.ParsePlatform("linux/amd64")
+		Expect(err).To(Succeed())
+		Expect(p).To(Equal(&artdesc.Platform{OS: "linux", Architecture: "amd64"}))
+		Expect(artdesc.PlatformString(p)).To(Equal("linux/amd64"))
+	})
+
+	It("rejects invalid platforms", func() {
+		for _, s := range []string{"", "linux", "linux/", "/amd64", "linux/arm/v7/x"} {
+			_, err := artdesc.ParsePlatform(s)
+			Expect(err).To(HaveOccurred(), s)
+		}
+	})
+
+	It("matches platforms", func() {
+		arm64 := &artdesc.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}
+		amd64 := &artdesc.Platform{OS: "linux", Architecture: "amd64"}
+
+		Expect(artdesc.MatchPlatform(arm64, &artdesc.Platform{OS: "linux", Architecture: "arm64"})).To(BeTrue())
+		Expect(artdesc.MatchPlatform(arm64, &artdesc.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"})).To(BeTrue())
+		Expect(artdesc.MatchPlatform(arm64, &artdesc.Platform{OS: "linux", Architecture: "arm64", Variant: "v7"})).To(BeFalse())
+		Expect(artdesc.MatchPlatform(amd64, &artdesc.Platform{OS: "windows", Architecture: "amd64"})).To(BeFalse())
+		Expect(artdesc.MatchPlatform(amd64, arm64, amd64)).To(BeTrue())
+		Expect(artdesc.MatchPlatform(nil, amd64)).To(BeFalse())
+	})
+
+	It("determines platform from image config", func() {
+		p, err := artdesc.PlatformForConfig([]byte(`{"os":"linux","architecture":"arm64","variant":"v8","rootfs":{"type":"layers"}}`))
+		Expect(err).To(Succeed())
+		Expect(p).To(Equal(&artdesc.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}))
+
+		_, err = artdesc.PlatformForConfig([]byte(`{}`))
+		Expect(err).To(MatchError("image config describes no platform"))
+	})
+})
@@ -0,0 +1,122 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package artdesc
+
+import (
+	"encoding/json"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+)
+
+// MediaTypeEmptyJSON is the media type of the empty JSON blob ({})
+// used as config for artefacts without configuration data.
+const MediaTypeEmptyJSON = "application/vnd.oci.empty.v1+json"
+
+// Referrer describes an artefact referring to another artefact
+// by its subject field.
+type Referrer struct {
+	Descriptor
+	// ArtifactType is the artifact type of the referring artefact.
+	ArtifactType string `json:"artifactType,omitempty"`
+}
+
+// Referrers is the image index describing the referrers of an artefact.
+// It is returned by the referrers API of the distribution spec
+// or stored under the referrers tag (see ReferrersTag).
+type Referrers struct {
+	specs.Versioned
+	MediaType   string            `json:"mediaType"`
+	Manifests   []Referrer        `json:"manifests"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+func NewReferrers() *Referrers {
+	return &Referrers{
+		Versioned: specs.Versioned{SchemaVersion: SchemeVersion},
+		MediaType: MediaTypeImageIndex,
+		Manifests: []Referrer{},
+	}
+}
+
+// Add adds or replaces the referrer with the digest of the given one.
+func (r *Referrers) Add(ref Referrer) {
+	for i, e := range r.Manifests {
+		if e.Digest == ref.Digest {
+			r.Manifests[i] = ref
+			return
+		}
+	}
+	r.Manifests = append(r.Manifests, ref)
+}
+
+// Filter returns the referrers with the given artifact type.
+// An empty type selects all referrers.
+func (r *Referrers) Filter(artifactType string) []Referrer {
+	result := []Referrer{}
+	for _, e := range r.Manifests {
+		if artifactType == "" || e.ArtifactType == artifactType {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func (r *Referrers) ToBlobAccess() (accessio.BlobAccess, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return accessio.BlobAccessForData(r.MediaType, data), nil
+}
+
+func DecodeReferrers(data []byte) (*Referrers, error) {
+	var r Referrers
+
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+	if r.Manifests == nil {
+		r.Manifests = []Referrer{}
+	}
+	return &r, nil
+}
+
+// ReferrersTag returns the tag used to store the referrers index
+// for an artefact in registries not supporting the referrers API
+// (referrers tag schema).
+func ReferrersTag(d digest.Digest) string {
+	tag := d.Algorithm().String() + "-" + d.Encoded()
+	if len(tag) > 128 {
+		tag = tag[:128]
+	}
+	return tag
+}
+
+// ReferrerFor provides the referrer descriptor for the given artefact
+// stored with the given blob.
+func ReferrerFor(blob accessio.BlobAccess, art *Artefact) Referrer {
+	return Referrer{
+		Descriptor: Descriptor{
+			MediaType:   blob.MimeType(),
+			Digest:      blob.Digest(),
+			Size:        blob.Size(),
+			Annotations: art.Annotations(),
+		},
+		ArtifactType: art.ArtifactType(),
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package artdesc_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+)
+
+var _ = Describe("referrers", func() {
+	subject := &artdesc.Descriptor{
+		MediaType: artdesc.MediaTypeImageManifest,
+		Digest:    digest.FromString("subject"),
+		Size:      7,
+	}
+
+	It("keeps subject and artifact type", func() {
+		art := artdesc.NewManifestArtefact()
+		m := art.Manifest()
+		m.ArtifactType = "application/vnd.example.sbom"
+		m.Subject = subject
+		m.Config = artdesc.Descriptor{MediaType: artdesc.MediaTypeEmptyJSON, Digest: digest.FromString("{}"), Size: 2}
+
+		data, err := artdesc.Encode(art)
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(ContainSubstring(`"artifactType":"application/vnd.example.sbom"`))
+
+		res, err := artdesc.Decode(data)
+		Expect(err).To(Succeed())
+		Expect(res.Subject()).To(Equal(subject))
+		Expect(res.ArtifactType()).To(Equal("application/vnd.example.sbom"))
+	})
+
+	It("uses config type as artifact type", func() {
+		art := artdesc.NewManifestArtefact()
+		art.Manifest().Config = artdesc.Descriptor{MediaType: "application/vnd.example.config", Digest: digest.FromString("{}"), Size: 2}
+		Expect(art.ArtifactType()).To(Equal("application/vnd.example.config"))
+		Expect(art.Subject()).To(BeNil())
+	})
+
+	It("filters referrers", func() {
+		r := artdesc.NewReferrers()
+		r.Add(artdesc.Referrer{Descriptor: artdesc.Descriptor{Digest: digest.FromString("a")}, ArtifactType: "sbom"})
+		r.Add(artdesc.Referrer{Descriptor: artdesc.Descriptor{Digest: digest.FromString("b")}, ArtifactType: "signature"})
+		r.Add(artdesc.Referrer{Descriptor: artdesc.Descriptor{Digest: digest.FromString("a")}, ArtifactType: "sbom"})
+		Expect(len(r.Manifests)).To(Equal(2))
+		Expect(r.Filter("")).To(Equal(r.Manifests))
+		Expect(r.Filter("signature")).To(Equal(r.Manifests[1:]))
+	})
+
+	It("provides referrers tag", func() {
+		Expect(artdesc.ReferrersTag(subject.Digest)).To(Equal("sha256-" + subject.Digest.Encoded()))
+	})
+})
@@ -62,6 +62,8 @@ type ArtefactSource interface {
 type NamespaceAccess interface {
 	ArtefactSource
 	ArtefactSink
+	ArtefactDeleter
+	ReferrersLister
 
 	GetNamespace() string
 	ListTags() ([]string, error)
@@ -71,6 +73,28 @@ type NamespaceAccess interface {
 	Close() error
 }
 
+// ArtefactDeleter supports the deletion of artefacts and tags.
+// Implementations not supporting a deletion return an
+// errors.ErrNotSupported error.
+type ArtefactDeleter interface {
+	// DeleteArtefact deletes the artefact given by a tag or digest
+	// including all its tags. Blobs only used by this artefact
+	// may be removed by the implementation.
+	DeleteArtefact(ref string) error
+	// DeleteTags removes the given tags. The tagged artefacts
+	// are kept.
+	DeleteTags(tags ...string) error
+}
+
+// ReferrersLister provides access to the artefacts referring to
+// another artefact by their subject field (OCI referrers API).
+type ReferrersLister interface {
+	// GetReferrers returns the descriptors of all artefacts referring
+	// to the artefact with the given digest. If an artifact type is given,
+	// only referrers with this type are returned.
+	GetReferrers(digest digest.Digest, artifactType string) ([]artdesc.Referrer, error)
+}
+
 type Artefact interface {
 	IsManifest() bool
 	IsIndex() bool
@@ -47,6 +47,8 @@ type (
 	BlobSink                         = core.BlobSink
 	NamespaceLister                  = core.NamespaceLister
 	NamespaceAccess                  = core.NamespaceAccess
+	ArtefactDeleter                  = core.ArtefactDeleter
+	ReferrersLister                  = core.ReferrersLister
 	ManifestAccess                   = core.ManifestAccess
 	IndexAccess                      = core.IndexAccess
 	BlobAccess                       = core.BlobAccess
@@ -92,11 +92,13 @@ func (a *ArtefactSetAccess) AddArtefact(artefact cpi.Artefact, tags ...string) (
 
 func (a *ArtefactSetAccess) AddBlob(blob cpi.BlobAccess) error {
 	a.lock.RLock()
-	defer a.lock.RUnlock()
 	err := a.base.AddBlob(blob)
+	a.lock.RUnlock()
 	if err != nil {
 		return err
 	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
 	a.blobinfos[blob.Digest()] = artdesc.DefaultBlobDescriptor(blob)
 	return nil
 }
@@ -17,7 +17,12 @@ package cpi
 import (
 	"strings"
 
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/grammar"
+	"github.com/open-component-model/ocm/pkg/errors"
 )
 
 type StringList []string
@@ -64,3 +69,75 @@ func FilterChildren(closure bool, list []string) []string {
 	}
 	return result
 }
+
+// AddArtefactBlobs adds the digests of all blobs used by the artefact
+// with the given digest (including the artefact blob itself) to the given set.
+// Artefacts described by an index are handled recursively.
+func AddArtefactBlobs(src BlobSource, d digest.Digest, set map[digest.Digest]bool) error {
+	if set[d] {
+		return nil
+	}
+	set[d] = true
+	_, data, err := src.GetBlobData(d)
+	if err != nil {
+		if accessio.IsErrBlobNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	blob, err := data.Get()
+	if err != nil {
+		return err
+	}
+	art, err := artdesc.Decode(blob)
+	if err != nil {
+		return errors.Wrapf(err, "artefact %s", d)
+	}
+	if art.IsManifest() {
+		m := art.Manifest()
+		set[m.Config.Digest] = true
+		for _, l := range m.Layers {
+			set[l.Digest] = true
+		}
+	}
+	if art.IsIndex() {
+		for _, m := range art.Index().Manifests {
+			if err := AddArtefactBlobs(src, m.Digest, set); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetReferrers determines the artefacts out of the given candidates
+// referring to the artefact with the given digest by their subject field.
+// If an artifact type is given, only referrers with this type are returned.
+func GetReferrers(src BlobSource, candidates []digest.Digest, subject digest.Digest, artifactType string) ([]artdesc.Referrer, error) {
+	result := []artdesc.Referrer{}
+	for _, d := range candidates {
+		size, data, err := src.GetBlobData(d)
+		if err != nil {
+			if accessio.IsErrBlobNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		blob, err := data.Get()
+		if err != nil {
+			return nil, err
+		}
+		art, err := artdesc.Decode(blob)
+		if err != nil {
+			return nil, errors.Wrapf(err, "artefact %s", d)
+		}
+		if s := art.Subject(); s == nil || s.Digest != subject {
+			continue
+		}
+		if artifactType != "" && art.ArtifactType() != artifactType {
+			continue
+		}
+		result = append(result, artdesc.ReferrerFor(accessio.BlobAccessForDataAccess(d, size, art.MimeType(), data), art))
+	}
+	return result, nil
+}
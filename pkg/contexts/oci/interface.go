@@ -44,6 +44,8 @@ type (
 	ArtefactAccess                   = core.ArtefactAccess
 	NamespaceLister                  = core.NamespaceLister
 	NamespaceAccess                  = core.NamespaceAccess
+	ArtefactDeleter                  = core.ArtefactDeleter
+	ReferrersLister                  = core.ReferrersLister
 	ManifestAccess                   = core.ManifestAccess
 	IndexAccess                      = core.IndexAccess
 	BlobAccess                       = core.BlobAccess
@@ -130,6 +130,116 @@ func (a *artefactSetImpl) AddTags(digest digest.Digest, tags ...string) error {
 	return errors.ErrUnknown(cpi.KIND_OCIARTEFACT, digest.String())
 }
 
+// DeleteArtefact removes the artefact with all its tags from the index.
+// Blobs not used anymore by any other artefact are removed, also.
+func (a *artefactSetImpl) DeleteArtefact(ref string) error {
+	if a.IsClosed() {
+		return accessio.ErrClosed
+	}
+	if a.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	a.base.Lock()
+	defer a.base.Unlock()
+
+	idx := a.GetIndex()
+	match := a.matcher(ref)
+	var d digest.Digest
+	for _, e := range idx.Manifests {
+		if match(&e) {
+			d = e.Digest
+			break
+		}
+	}
+	if d == "" {
+		return errors.ErrNotFound(cpi.KIND_OCIARTEFACT, ref)
+	}
+
+	candidates := map[digest.Digest]bool{}
+	err := cpi.AddArtefactBlobs(a.base, d, candidates)
+	if err != nil {
+		return err
+	}
+	n := 0
+	for _, e := range idx.Manifests {
+		if e.Digest != d {
+			idx.Manifests[n] = e
+			n++
+		}
+	}
+	idx.Manifests = idx.Manifests[:n]
+	if idx.Annotations != nil && idx.Annotations[MAINARTEFACT_ANNOTATION] == d.String() {
+		delete(idx.Annotations, MAINARTEFACT_ANNOTATION)
+	}
+
+	used := map[digest.Digest]bool{}
+	for _, e := range idx.Manifests {
+		if err := cpi.AddArtefactBlobs(a.base, e.Digest, used); err != nil {
+			return err
+		}
+	}
+	list := errors.ErrListf("blob cleanup")
+	for d := range candidates {
+		if !used[d] {
+			list.Add(a.base.RemoveBlob(d))
+		}
+	}
+	return list.Result()
+}
+
+// DeleteTags removes the given tags from the index.
+// The tagged artefacts are kept.
+func (a *artefactSetImpl) DeleteTags(tags ...string) error {
+	if a.IsClosed() {
+		return accessio.ErrClosed
+	}
+	if a.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	a.base.Lock()
+	defer a.base.Unlock()
+
+	idx := a.GetIndex()
+	for _, tag := range tags {
+		found := false
+		for _, e := range idx.Manifests {
+			if e.Annotations == nil {
+				continue
+			}
+			cur := strings.Split(e.Annotations[TAGS_ANNOTATION], ",")
+			for i, t := range cur {
+				if t == tag {
+					cur = append(cur[:i], cur[i+1:]...)
+					found = true
+					break
+				}
+			}
+			if found {
+				if len(cur) == 0 {
+					delete(e.Annotations, TAGS_ANNOTATION)
+				} else {
+					e.Annotations[TAGS_ANNOTATION] = strings.Join(cur, ",")
+				}
+				break
+			}
+		}
+		if !found {
+			return errors.ErrUnknown(cpi.KIND_OCIARTEFACT, tag)
+		}
+	}
+	return nil
+}
+
+// GetReferrers evaluates the subject fields of all artefacts
+// of the set to find the referrers of an artefact.
+func (a *artefactSetImpl) GetReferrers(d digest.Digest, artifactType string) ([]artdesc.Referrer, error) {
+	var candidates []digest.Digest
+	for _, e := range a.GetIndex().Manifests {
+		candidates = append(candidates, e.Digest)
+	}
+	return cpi.GetReferrers(a.base, candidates, d, artifactType)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // forward
 
@@ -340,6 +450,30 @@ func (a *artefactSetImpl) AddPlatformArtefact(artefact cpi.Artefact, platform *a
 	return blob, nil
 }
 
+// AddArtefactDescriptor adds an index entry for an artefact, whose
+// serialized form has already been added as blob. This can be used
+// to keep the original manifest bytes (and digests) of an artefact.
+func (a *artefactSetImpl) AddArtefactDescriptor(desc *cpi.Descriptor, tags ...string) error {
+	if a.IsClosed() {
+		return accessio.ErrClosed
+	}
+	if a.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	a.base.Lock()
+	idx := a.GetIndex()
+	idx.Manifests = append(idx.Manifests, cpi.Descriptor{
+		MediaType:   desc.MediaType,
+		Digest:      desc.Digest,
+		Size:        desc.Size,
+		URLs:        nil,
+		Annotations: nil,
+		Platform:    desc.Platform,
+	})
+	a.base.Unlock()
+	return a.AddTags(desc.Digest, tags...)
+}
+
 func (a *artefactSetImpl) NewArtefact(artefact ...*artdesc.Artefact) (cpi.ArtefactAccess, error) {
 	if a.IsClosed() {
 		return nil, accessio.ErrClosed
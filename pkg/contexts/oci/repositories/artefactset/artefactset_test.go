@@ -206,4 +206,28 @@ var _ = Describe("artefact management", func() {
 	Context("index", func() {
 
 	})
+
+	Context("deletion", func() {
+		It("deletes tags and artefacts", func() {
+			a, err := artefactset.FormatDirectory.Create("test", opts, 0700)
+			Expect(err).To(Succeed())
+			art := NewArtefact(a)
+			_, err = a.AddArtefact(art, "v1", "latest")
+			Expect(err).To(Succeed())
+			art.Close()
+
+			Expect(a.DeleteTags("latest")).To(Succeed())
+			_, err = a.GetArtefact("latest")
+			Expect(err).To(HaveOccurred())
+			Expect(a.DeleteTags("latest")).NotTo(Succeed())
+
+			Expect(a.DeleteArtefact("v1")).To(Succeed())
+			Expect(len(a.GetIndex().Manifests)).To(Equal(0))
+			Expect(a.Close()).To(Succeed())
+
+			infos, err := vfs.ReadDir(tempfs, "test/"+artefactset.BlobsDirectoryName)
+			Expect(err).To(Succeed())
+			Expect(infos).To(BeEmpty())
+		})
+	})
 })
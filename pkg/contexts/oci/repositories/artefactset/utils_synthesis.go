@@ -16,6 +16,7 @@ package artefactset
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/mandelsoft/vfs/pkg/vfs"
@@ -25,6 +26,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/cpi"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/transfer"
+	"github.com/open-component-model/ocm/pkg/errors"
 )
 
 const SynthesizedBlobFormat = "+tar+gzip"
@@ -66,6 +68,8 @@ func TransferArtefact(art cpi.ArtefactAccess, set cpi.ArtefactSink, tags ...stri
 // SynthesizeArtefactBlob synthesizes an artefact blob incorporating all side artefacts.
 // To support extensions like cosign, we need the namespace access her to find
 // additionally objects associated by tags.
+// Artefacts referring to the artefact by their subject field (OCI referrers)
+// are incorporated, also.
 func SynthesizeArtefactBlob(ns cpi.NamespaceAccess, ref string) (ArtefactBlob, error) {
 	art, err := ns.GetArtefact(ref)
 	if err != nil {
@@ -81,7 +85,7 @@ func SynthesizeArtefactBlob(ns cpi.NamespaceAccess, ref string) (ArtefactBlob, e
 	digest := blob.Digest()
 
 	return SythesizeArtefactSet(blob.MimeType(), func(set *ArtefactSet) error {
-		err = TransferArtefact(art, set)
+		err = transfer.TransferArtefactWithOptions(art, set, &transfer.Options{Referrers: ns})
 		if err != nil {
 			return fmt.Errorf("failed to transfer artifact: %w", err)
 		}
@@ -98,3 +102,58 @@ func SynthesizeArtefactBlob(ns cpi.NamespaceAccess, ref string) (ArtefactBlob, e
 		return nil
 	})
 }
+
+// FilterArtefactBlobForPlatforms provides an artefact blob for the main
+// artefact of the given artefact set blob reduced to the manifests for the
+// given platforms (see transfer.TransferIndexForPlatforms).
+// If the main artefact is no index, nil is returned.
+func FilterArtefactBlobForPlatforms(blob accessio.BlobAccess, platforms []*artdesc.Platform) (ArtefactBlob, error) {
+	src, err := OpenFromBlob(accessobj.ACC_READONLY, blob)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	idx := src.GetIndex()
+	main := src.GetMain()
+	if main == "" {
+		if len(idx.Manifests) != 1 {
+			return nil, errors.Newf("no main artefact found in artefact set")
+		}
+		main = idx.Manifests[0].Digest
+	}
+	var tags []string
+	for _, e := range idx.Manifests {
+		if e.Digest == main && e.Annotations != nil && e.Annotations[TAGS_ANNOTATION] != "" {
+			tags = strings.Split(e.Annotations[TAGS_ANNOTATION], ",")
+		}
+	}
+
+	art, err := src.GetArtefact(main.String())
+	if err != nil {
+		return nil, err
+	}
+	defer art.Close()
+	if !art.IsIndex() {
+		return nil, nil
+	}
+
+	mime := art.GetDescriptor().MimeType()
+	var selected []artdesc.Descriptor
+	for _, m := range art.IndexAccess().GetDescriptor().Manifests {
+		if artdesc.MatchPlatform(m.Platform, platforms...) {
+			selected = append(selected, m)
+		}
+	}
+	if len(selected) == 1 {
+		mime = selected[0].MediaType
+	}
+	return SythesizeArtefactSet(mime, func(set *ArtefactSet) error {
+		d, err := transfer.TransferIndexForPlatforms(art.IndexAccess(), set, platforms, tags...)
+		if err != nil {
+			return err
+		}
+		set.Annotate(MAINARTEFACT_ANNOTATION, d.String())
+		return nil
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ctf
+
+import (
+	"sort"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// GCResult describes the blobs found to be obsolete by a garbage collection.
+type GCResult struct {
+	// Blobs is the ordered list of obsolete blobs.
+	Blobs []digest.Digest
+	// Size is the accumulated size of the obsolete blobs.
+	Size int64
+}
+
+// GC removes all blobs from a Common Transport Archive, which are not used
+// by any artefact listed in its index. In dry-run mode the obsolete blobs
+// are only determined, but not removed.
+func GC(repo cpi.Repository, dryrun bool) (*GCResult, error) {
+	r, ok := repo.(*Repository)
+	if !ok {
+		return nil, errors.ErrInvalid("repository type", repo.GetSpecification().GetKind())
+	}
+	return r.GC(dryrun)
+}
+
+// GC removes all blobs not used by any artefact listed in the index.
+// In dry-run mode the obsolete blobs are only determined, but not removed.
+func (r *RepositoryImpl) GC(dryrun bool) (*GCResult, error) {
+	if r.IsClosed() {
+		return nil, accessio.ErrClosed
+	}
+	if !dryrun && r.IsReadOnly() {
+		return nil, accessio.ErrReadOnly
+	}
+	r.base.Lock()
+	defer r.base.Unlock()
+
+	used, err := r.getUsedBlobs()
+	if err != nil {
+		return nil, err
+	}
+
+	fs := r.base.Access().GetFileSystem()
+	entries, err := vfs.ReadDir(fs, r.base.BlobPath(""))
+	if err != nil {
+		if vfs.IsErrNotExist(err) {
+			return &GCResult{}, nil
+		}
+		return nil, err
+	}
+
+	result := &GCResult{}
+	list := errors.ErrListf("garbage collection")
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		d := common.PathToDigest(e.Name())
+		if d.Validate() != nil || used[d] {
+			continue
+		}
+		result.Blobs = append(result.Blobs, d)
+		result.Size += e.Size()
+		if !dryrun {
+			list.Add(r.base.RemoveBlob(d))
+		}
+	}
+	sort.Slice(result.Blobs, func(i, j int) bool { return result.Blobs[i] < result.Blobs[j] })
+	return result, list.Result()
+}
+
+// getUsedBlobs determines the digests of all blobs used by
+// the artefacts listed in the repository index.
+func (r *RepositoryImpl) getUsedBlobs() (map[digest.Digest]bool, error) {
+	used := map[digest.Digest]bool{}
+	for _, d := range r.getIndex().DigestList() {
+		if err := cpi.AddArtefactBlobs(r.base, d, used); err != nil {
+			return nil, err
+		}
+	}
+	return used, nil
+}
+
+// cleanupBlobs removes the given candidate blobs, if they are not
+// used anymore by any artefact listed in the repository index.
+func (r *RepositoryImpl) cleanupBlobs(candidates map[digest.Digest]bool) error {
+	used, err := r.getUsedBlobs()
+	if err != nil {
+		return err
+	}
+	list := errors.ErrListf("blob cleanup")
+	for d := range candidates {
+		if !used[d] {
+			list.Add(r.base.RemoveBlob(d))
+		}
+	}
+	return list.Result()
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ctf_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf/testhelper"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+var _ = Describe("ctf garbage collection", func() {
+	var tempfs vfs.FileSystem
+	var orphan accessio.BlobAccess
+
+	blobPath := func(name string) string {
+		return "test/" + ctf.BlobsDirectoryName + "/" + name
+	}
+
+	BeforeEach(func() {
+		t, err := osfs.NewTempFileSystem()
+		Expect(err).To(Succeed())
+		tempfs = t
+
+		r, err := ctf.Create(oci.DefaultContext(), accessobj.ACC_CREATE, "test", 0o700, accessio.PathFileSystem(tempfs))
+		Expect(err).To(Succeed())
+		n, err := r.LookupNamespace("mandelsoft/test")
+		Expect(err).To(Succeed())
+		DefaultManifestFill(n)
+		orphan = accessio.BlobAccessForString(mime.MIME_TEXT, "orphan")
+		Expect(n.AddBlob(orphan)).To(Succeed())
+		Expect(n.Close()).To(Succeed())
+		Expect(r.Close()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		vfs.Cleanup(tempfs)
+	})
+
+	It("reports obsolete blobs in dry-run mode", func() {
+		r, err := ctf.Open(oci.DefaultContext(), accessobj.ACC_READONLY, "test", 0, accessio.PathFileSystem(tempfs))
+		Expect(err).To(Succeed())
+		defer r.Close()
+
+		result, err := ctf.GC(r, true)
+		Expect(err).To(Succeed())
+		Expect(result.Blobs).To(Equal([]digest.Digest{orphan.Digest()}))
+		Expect(result.Size).To(Equal(orphan.Size()))
+		Expect(vfs.FileExists(tempfs, blobPath(common.DigestToFileName(orphan.Digest())))).To(BeTrue())
+	})
+
+	It("removes obsolete blobs", func() {
+		r, err := ctf.Open(oci.DefaultContext(), accessobj.ACC_WRITABLE, "test", 0, accessio.PathFileSystem(tempfs))
+		Expect(err).To(Succeed())
+
+		result, err := ctf.GC(r, false)
+		Expect(err).To(Succeed())
+		Expect(result.Blobs).To(Equal([]digest.Digest{orphan.Digest()}))
+		Expect(r.Close()).To(Succeed())
+
+		Expect(vfs.FileExists(tempfs, blobPath(common.DigestToFileName(orphan.Digest())))).To(BeFalse())
+		Expect(vfs.FileExists(tempfs, blobPath("sha256."+DIGEST_MANIFEST))).To(BeTrue())
+		Expect(vfs.FileExists(tempfs, blobPath("sha256."+DIGEST_CONFIG))).To(BeTrue())
+		Expect(vfs.FileExists(tempfs, blobPath("sha256."+DIGEST_LAYER))).To(BeTrue())
+	})
+
+	It("removes blobs of deleted artefacts", func() {
+		r, err := ctf.Open(oci.DefaultContext(), accessobj.ACC_WRITABLE, "test", 0, accessio.PathFileSystem(tempfs))
+		Expect(err).To(Succeed())
+		n, err := r.LookupNamespace("mandelsoft/test")
+		Expect(err).To(Succeed())
+		Expect(n.(oci.ArtefactDeleter).DeleteArtefact(TAG)).To(Succeed())
+		Expect(n.ListTags()).To(BeEmpty())
+		Expect(n.Close()).To(Succeed())
+
+		result, err := ctf.GC(r, true)
+		Expect(err).To(Succeed())
+		Expect(result.Blobs).To(Equal([]digest.Digest{orphan.Digest()}))
+		Expect(r.Close()).To(Succeed())
+
+		Expect(vfs.FileExists(tempfs, blobPath("sha256."+DIGEST_MANIFEST))).To(BeFalse())
+		Expect(vfs.FileExists(tempfs, blobPath("sha256."+DIGEST_CONFIG))).To(BeFalse())
+		Expect(vfs.FileExists(tempfs, blobPath("sha256."+DIGEST_LAYER))).To(BeFalse())
+	})
+})
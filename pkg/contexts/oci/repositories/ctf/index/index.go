@@ -110,6 +110,95 @@ func (r *RepositoryIndex) addArtefactInfo(n *ArtefactMeta) {
 	}
 }
 
+// DeleteArtefactInfo removes all entries (tags and digest) for the
+// given artefact digest in the given repository.
+func (r *RepositoryIndex) DeleteArtefactInfo(repo string, digest digest.Digest) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	repos := r.byRepository[repo]
+	for t, e := range repos {
+		if e.Digest == digest {
+			delete(repos, t)
+		}
+	}
+	if len(repos) == 0 {
+		delete(r.byRepository, repo)
+	}
+
+	list := r.byDigest[digest]
+	n := 0
+	for _, e := range list {
+		if e.Repository != repo {
+			list[n] = e
+			n++
+		}
+	}
+	if n == 0 {
+		delete(r.byDigest, digest)
+	} else {
+		r.byDigest[digest] = list[:n]
+	}
+}
+
+// DeleteTagsFor removes the given tags from the given repository.
+// The tagged artefacts are kept, even if they are not tagged anymore.
+func (r *RepositoryIndex) DeleteTagsFor(repo string, tags ...string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	repos := r.byRepository[repo]
+	for _, tag := range tags {
+		var m *ArtefactMeta
+		if repos != nil && !strings.HasPrefix(tag, "@") {
+			m = repos[tag]
+		}
+		if m == nil {
+			return cpi.ErrUnknownArtefact(repo, tag)
+		}
+		delete(repos, tag)
+
+		var other *ArtefactMeta
+		list := r.byDigest[m.Digest]
+		for _, e := range list {
+			if e != m && e.Repository == repo {
+				other = e
+				break
+			}
+		}
+		if other == nil {
+			// keep the artefact as untagged entry
+			m.Tag = ""
+			continue
+		}
+		n := 0
+		for _, e := range list {
+			if e != m {
+				list[n] = e
+				n++
+			}
+		}
+		r.byDigest[m.Digest] = list[:n]
+		if repos["@"+m.Digest.String()] == m {
+			repos["@"+m.Digest.String()] = other
+		}
+	}
+	return nil
+}
+
+// DigestList returns the digests of all artefacts
+// described by the index.
+func (r *RepositoryIndex) DigestList() []digest.Digest {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	result := []digest.Digest{}
+	for d := range r.byDigest {
+		result = append(result, d)
+	}
+	return result
+}
+
 func (r *RepositoryIndex) HasArtefact(repo, tag string) bool {
 	r.lock.RLock()
 	defer r.lock.RUnlock()
@@ -148,6 +237,7 @@ func (r *RepositoryIndex) GetTags(repo string) []string {
 		}
 	}
 	*/
+	sort.Strings(result)
 	return result
 }
 
@@ -166,6 +256,22 @@ func (r *RepositoryIndex) GetArtefacts(repo string) []string {
 	return result
 }
 
+// GetDigests returns the digests of all artefacts of a repository.
+func (r *RepositoryIndex) GetDigests(repo string) []digest.Digest {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	found := map[digest.Digest]bool{}
+	result := []digest.Digest{}
+	for _, m := range r.byRepository[repo] {
+		if !found[m.Digest] {
+			found[m.Digest] = true
+			result = append(result, m.Digest)
+		}
+	}
+	return result
+}
+
 func (r *RepositoryIndex) GetArtefactInfos(digest digest.Digest) []*ArtefactMeta {
 	r.lock.RLock()
 	defer r.lock.RUnlock()
@@ -178,4 +178,65 @@ var _ = Describe("index", func() {
 			})
 		})
 	})
+
+	Context("deletion", func() {
+		It("deletes all tags of a digest", func() {
+			a1 := NewMeta("repo1", "v1", "digest1")
+			a2 := NewMeta("repo1", "v2", "digest1")
+			a3 := NewMeta("repo1", "v3", "digest2")
+			rindex.AddArtefactInfo(a1)
+			rindex.AddArtefactInfo(a2)
+			rindex.AddArtefactInfo(a3)
+
+			rindex.DeleteArtefactInfo("repo1", "digest1")
+			Expect(rindex.GetArtefactInfo("repo1", "digest1")).To(BeNil())
+			Expect(rindex.GetArtefactInfo("repo1", "v1")).To(BeNil())
+			Expect(rindex.GetArtefactInfo("repo1", "v2")).To(BeNil())
+			Expect(rindex.GetArtefactInfos("digest1")).To(BeEmpty())
+			Expect(rindex.DigestList()).To(ConsistOf(a3.Digest))
+			Expect(rindex.GetDescriptor().Index).To(Equal([]ArtefactMeta{
+				*a3,
+			}))
+		})
+
+		It("keeps shared entries of other repos", func() {
+			a1 := NewMeta("repo1", "v1", "digest1")
+			a2 := NewMeta("repo2", "v2", "digest1")
+			rindex.AddArtefactInfo(a1)
+			rindex.AddArtefactInfo(a2)
+
+			rindex.DeleteArtefactInfo("repo1", "digest1")
+			Expect(rindex.RepositoryList()).To(ConsistOf("repo2"))
+			Expect(rindex.GetArtefactInfos("digest1")).To(ConsistOf(a2))
+			Expect(rindex.GetDescriptor().Index).To(Equal([]ArtefactMeta{
+				*a2,
+			}))
+		})
+
+		It("deletes tags", func() {
+			a1 := NewMeta("repo1", "v1", "digest1")
+			a2 := NewMeta("repo1", "v2", "digest1")
+			a3 := NewMeta("repo1", "v3", "digest2")
+			rindex.AddArtefactInfo(a1)
+			rindex.AddArtefactInfo(a2)
+			rindex.AddArtefactInfo(a3)
+
+			Expect(rindex.DeleteTagsFor("repo1", "v1", "v3")).To(Succeed())
+			Expect(rindex.GetArtefactInfo("repo1", "v1")).To(BeNil())
+			Expect(rindex.GetArtefactInfo("repo1", "v3")).To(BeNil())
+			Expect(rindex.GetArtefactInfo("repo1", "v2")).To(Equal(a2))
+			Expect(rindex.GetTags("repo1")).To(ConsistOf("v2"))
+			Expect(rindex.DigestList()).To(ConsistOf(a1.Digest, a3.Digest))
+			Expect(rindex.GetDescriptor().Index).To(Equal([]ArtefactMeta{
+				*NewMeta("repo1", "", "digest2"),
+				*a2,
+			}))
+		})
+
+		It("rejects unknown tags", func() {
+			rindex.AddArtefactInfo(NewMeta("repo1", "v1", "digest1"))
+			Expect(rindex.DeleteTagsFor("repo1", "v2")).NotTo(Succeed())
+			Expect(rindex.DeleteTagsFor("repo1", "@digest1")).NotTo(Succeed())
+		})
+	})
 })
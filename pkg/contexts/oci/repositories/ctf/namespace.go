@@ -146,6 +146,49 @@ func (n *NamespaceContainer) AddTags(digest digest.Digest, tags ...string) error
 	return n.repo.getIndex().AddTagsFor(n.namespace, digest, tags...)
 }
 
+// DeleteArtefact removes the artefact with all its tags from the index.
+// Blobs not used anymore by any other artefact in the repository are removed, also.
+func (n *NamespaceContainer) DeleteArtefact(vers string) error {
+	if n.IsClosed() {
+		return accessio.ErrClosed
+	}
+	if n.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	n.repo.base.Lock()
+	defer n.repo.base.Unlock()
+
+	meta := n.repo.getIndex().GetArtefactInfo(n.namespace, vers)
+	if meta == nil {
+		return errors.ErrNotFound(cpi.KIND_OCIARTEFACT, vers, n.namespace)
+	}
+	candidates := map[digest.Digest]bool{}
+	err := cpi.AddArtefactBlobs(n.repo.base, meta.Digest, candidates)
+	if err != nil {
+		return err
+	}
+	n.repo.getIndex().DeleteArtefactInfo(n.namespace, meta.Digest)
+	return n.repo.cleanupBlobs(candidates)
+}
+
+// DeleteTags removes the given tags from the index.
+// The tagged artefacts are kept, even if they are not tagged anymore.
+func (n *NamespaceContainer) DeleteTags(tags ...string) error {
+	if n.IsClosed() {
+		return accessio.ErrClosed
+	}
+	if n.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	return n.repo.getIndex().DeleteTagsFor(n.namespace, tags...)
+}
+
+// GetReferrers evaluates the subject fields of all artefacts
+// of the namespace to find the referrers of an artefact.
+func (n *NamespaceContainer) GetReferrers(digest digest.Digest, artifactType string) ([]artdesc.Referrer, error) {
+	return cpi.GetReferrers(n.repo.base, n.repo.getIndex().GetDigests(n.namespace), digest, artifactType)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func (n *NamespaceContainer) GetRepository() cpi.Repository {
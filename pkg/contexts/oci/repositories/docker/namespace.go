@@ -226,6 +226,20 @@ func (n *NamespaceContainer) AddTags(digest digest.Digest, tags ...string) error
 	return nil
 }
 
+func (n *NamespaceContainer) DeleteArtefact(vers string) error {
+	return errors.ErrNotSupported(errors.KIND_FUNCTION, "delete", Type)
+}
+
+func (n *NamespaceContainer) DeleteTags(tags ...string) error {
+	return errors.ErrNotSupported(errors.KIND_FUNCTION, "delete", Type)
+}
+
+// GetReferrers always returns an empty list, because the docker daemon
+// does not keep referring artefacts.
+func (n *NamespaceContainer) GetReferrers(digest digest.Digest, artifactType string) ([]artdesc.Referrer, error) {
+	return nil, nil
+}
+
 func (n *NamespaceContainer) NewArtefactProvider(state accessobj.State) (cpi.ArtefactProvider, error) {
 	return nil, nil
 }
@@ -290,3 +304,15 @@ func (n *Namespace) AddTags(digest digest.Digest, tags ...string) error {
 func (n *Namespace) AddBlob(blob cpi.BlobAccess) error {
 	return n.access.AddBlob(blob)
 }
+
+func (n *Namespace) DeleteArtefact(vers string) error {
+	return n.access.DeleteArtefact(vers)
+}
+
+func (n *Namespace) DeleteTags(tags ...string) error {
+	return n.access.DeleteTags(tags...)
+}
+
+func (n *Namespace) GetReferrers(digest digest.Digest, artifactType string) ([]artdesc.Referrer, error) {
+	return n.access.GetReferrers(digest, artifactType)
+}
@@ -185,7 +185,12 @@ func (n *NamespaceContainer) AddArtefact(artefact cpi.Artefact, tags ...string)
 			}
 		}
 	}
-
+	if s := artefact.Artefact().Subject(); s != nil {
+		err = n.addReferrer(s.Digest, artdesc.ReferrerFor(blob, artefact.Artefact()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "updating referrers for %s", s.Digest)
+		}
+	}
 	return blob, err
 }
 
@@ -211,6 +216,124 @@ func (n *NamespaceContainer) AddTags(digest digest.Digest, tags ...string) error
 	return nil
 }
 
+// DeleteArtefact deletes the manifest of the artefact given by a tag or digest.
+// All tags referring to this manifest are deleted by the registry.
+func (n *NamespaceContainer) DeleteArtefact(vers string) error {
+	ref := n.repo.getRef(n.namespace, vers)
+	_, desc, err := n.resolver.Resolve(dummyContext, ref)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return errors.ErrNotFound(cpi.KIND_OCIARTEFACT, ref, n.namespace)
+		}
+		return err
+	}
+	return n.delete(desc.Digest.String())
+}
+
+// DeleteTags deletes tags using the distribution API.
+// This is not supported by all registries.
+func (n *NamespaceContainer) DeleteTags(tags ...string) error {
+	for _, tag := range tags {
+		if ok, _ := artdesc.IsDigest(tag); ok {
+			return errors.ErrInvalid("tag", tag)
+		}
+		err := n.delete(tag)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *NamespaceContainer) delete(vers string) error {
+	ref := n.repo.getRef(n.namespace, vers)
+	logrus.Infof("deleting %s", ref)
+	d, err := n.resolver.Deleter(dummyContext, ref)
+	if err != nil {
+		return err
+	}
+	err = d.Delete(dummyContext)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return errors.ErrNotFound(cpi.KIND_OCIARTEFACT, ref, n.namespace)
+		}
+		if errdefs.IsNotImplemented(err) {
+			return errors.ErrNotSupported(errors.KIND_FUNCTION, "delete", n.repo.info.Locator)
+		}
+	}
+	return err
+}
+
+// GetReferrers uses the referrers API of the registry. For registries
+// not supporting this API, the referrers index stored under the
+// referrers tag is used (referrers tag schema).
+func (n *NamespaceContainer) GetReferrers(digest digest.Digest, artifactType string) ([]artdesc.Referrer, error) {
+	idx, _, err := n.getReferrers(digest, artifactType)
+	if err != nil {
+		return nil, err
+	}
+	return idx.Filter(artifactType), nil
+}
+
+// getReferrers returns the referrers index for an artefact and whether
+// it has been provided by the referrers API.
+func (n *NamespaceContainer) getReferrers(digest digest.Digest, artifactType string) (*artdesc.Referrers, bool, error) {
+	r, err := n.resolver.Referrers(dummyContext, n.repo.getRef(n.namespace, digest.String()))
+	if err != nil {
+		return nil, false, err
+	}
+	data, err := r.GetReferrers(dummyContext, artifactType)
+	if err == nil {
+		idx, err := artdesc.DecodeReferrers(data)
+		return idx, true, err
+	}
+	if !errdefs.IsNotImplemented(err) {
+		return nil, false, err
+	}
+	idx, err := n.getReferrersIndex(digest)
+	return idx, false, err
+}
+
+// getReferrersIndex reads the referrers index stored under the referrers tag.
+func (n *NamespaceContainer) getReferrersIndex(digest digest.Digest) (*artdesc.Referrers, error) {
+	ref := n.repo.getRef(n.namespace, artdesc.ReferrersTag(digest))
+	_, desc, err := n.resolver.Resolve(dummyContext, ref)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return artdesc.NewReferrers(), nil
+		}
+		return nil, err
+	}
+	_, acc, err := n.blobs.Get(desc.MediaType).GetBlobData(desc.Digest)
+	if err != nil {
+		return nil, err
+	}
+	data, err := acc.Get()
+	if err != nil {
+		return nil, err
+	}
+	return artdesc.DecodeReferrers(data)
+}
+
+// addReferrer updates the referrers index stored under the referrers tag,
+// if the registry does not support the referrers API.
+func (n *NamespaceContainer) addReferrer(digest digest.Digest, ref artdesc.Referrer) error {
+	idx, api, err := n.getReferrers(digest, "")
+	if err != nil || api {
+		return err
+	}
+	idx.Add(ref)
+	blob, err := idx.ToBlobAccess()
+	if err != nil {
+		return err
+	}
+	_, _, err = n.blobs.Get(blob.MimeType()).AddBlob(blob)
+	if err != nil {
+		return err
+	}
+	return n.push(artdesc.ReferrersTag(digest), blob)
+}
+
 func (n *NamespaceContainer) NewArtefactProvider(state accessobj.State) (cpi.ArtefactProvider, error) {
 	return cpi.NewNopCloserArtefactProvider(n), nil
 }
@@ -259,3 +382,15 @@ func (n *Namespace) AddTags(digest digest.Digest, tags ...string) error {
 func (n *Namespace) AddBlob(blob cpi.BlobAccess) error {
 	return n.access.AddBlob(blob)
 }
+
+func (n *Namespace) DeleteArtefact(vers string) error {
+	return n.access.DeleteArtefact(vers)
+}
+
+func (n *Namespace) DeleteTags(tags ...string) error {
+	return n.access.DeleteTags(tags...)
+}
+
+func (n *Namespace) GetReferrers(digest digest.Digest, artifactType string) ([]artdesc.Referrer, error) {
+	return n.access.GetReferrers(digest, artifactType)
+}
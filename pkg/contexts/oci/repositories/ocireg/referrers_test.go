@@ -0,0 +1,274 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ocireg_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf/testhelper"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ocireg"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const NAMESPACE = "test"
+const ARTIFACT_TYPE = "application/vnd.example.sbom"
+
+// registry is a minimal in-memory implementation of the OCI distribution
+// API sufficient for pushing and pulling artefacts. The referrers API
+// is optional.
+type registry struct {
+	lock      sync.Mutex
+	referrers bool
+	blobs     map[digest.Digest][]byte
+	manifests map[digest.Digest]string
+	tags      map[string]digest.Digest
+	uploads   int
+}
+
+func newRegistry(referrers bool) *registry {
+	return &registry{
+		referrers: referrers,
+		blobs:     map[digest.Digest][]byte{},
+		manifests: map[digest.Digest]string{},
+		tags:      map[string]digest.Digest{},
+	}
+}
+
+func (r *registry) Tags() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var tags []string
+	for t := range r.tags {
+		tags = append(tags, t)
+	}
+	return tags
+}
+
+func (r *registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	p := req.URL.Path
+	if p == "/v2/" || p == "/v2" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	for _, kind := range []string{"/blobs/uploads/", "/manifests/", "/referrers/", "/blobs/"} {
+		if i := strings.LastIndex(p, kind); i > 0 && strings.HasPrefix(p, "/v2/") {
+			r.handle(w, req, p[len("/v2/"):i], kind, p[i+len(kind):])
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func (r *registry) handle(w http.ResponseWriter, req *http.Request, ns, kind, ref string) {
+	switch {
+	case kind == "/blobs/uploads/" && req.Method == http.MethodPost:
+		r.uploads++
+		w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/uploads/%d", ns, r.uploads))
+		w.WriteHeader(http.StatusAccepted)
+	case kind == "/blobs/uploads/" && req.Method == http.MethodPut:
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		d := digest.Digest(req.URL.Query().Get("digest"))
+		if d != digest.FromBytes(data) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.blobs[d] = data
+		w.Header().Set("Docker-Content-Digest", d.String())
+		w.WriteHeader(http.StatusCreated)
+	case kind == "/blobs/":
+		r.serve(w, req, digest.Digest(ref), "application/octet-stream")
+	case kind == "/manifests/" && req.Method == http.MethodPut:
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		d := digest.FromBytes(data)
+		r.blobs[d] = data
+		r.manifests[d] = req.Header.Get("Content-Type")
+		if _, err := digest.Parse(ref); err != nil {
+			r.tags[ns+":"+ref] = d
+		}
+		w.Header().Set("Docker-Content-Digest", d.String())
+		w.WriteHeader(http.StatusCreated)
+	case kind == "/manifests/":
+		d, err := digest.Parse(ref)
+		if err != nil {
+			d = r.tags[ns+":"+ref]
+		}
+		r.serve(w, req, d, r.manifests[d])
+	case kind == "/referrers/" && r.referrers:
+		r.serveReferrers(w, digest.Digest(ref), req.URL.Query().Get("artifactType"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (r *registry) serve(w http.ResponseWriter, req *http.Request, d digest.Digest, mediaType string) {
+	data, ok := r.blobs[d]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Set("Docker-Content-Digest", d.String())
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(data)))
+	w.WriteHeader(http.StatusOK)
+	if req.Method == http.MethodGet {
+		w.Write(data)
+	}
+}
+
+func (r *registry) serveReferrers(w http.ResponseWriter, d digest.Digest, artifactType string) {
+	idx := artdesc.NewReferrers()
+	for md, mediaType := range r.manifests {
+		var m artdesc.Manifest
+		if err := json.Unmarshal(r.blobs[md], &m); err != nil || m.Subject == nil || m.Subject.Digest != d {
+			continue
+		}
+		if artifactType == "" || m.ArtifactType == artifactType {
+			idx.Add(artdesc.Referrer{
+				Descriptor: artdesc.Descriptor{
+					MediaType: mediaType,
+					Digest:    md,
+					Size:      int64(len(r.blobs[md])),
+				},
+				ArtifactType: m.ArtifactType,
+			})
+		}
+	}
+	data, err := json.Marshal(idx)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", artdesc.MediaTypeImageIndex)
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+var _ = Describe("referrers", func() {
+	var reg *registry
+	var server *httptest.Server
+	var repo oci.Repository
+	var ns oci.NamespaceAccess
+
+	setup := func(referrers bool) {
+		reg = newRegistry(referrers)
+		server = httptest.NewServer(reg)
+
+		var err error
+		repo, err = oci.New().RepositoryForSpec(ocireg.NewRepositorySpec(server.URL))
+		Expect(err).To(Succeed())
+		ns, err = repo.LookupNamespace(NAMESPACE)
+		Expect(err).To(Succeed())
+	}
+
+	AfterEach(func() {
+		Expect(ns.Close()).To(Succeed())
+		Expect(repo.Close()).To(Succeed())
+		server.Close()
+	})
+
+	// addArtefacts adds an artefact and a referrer for it and
+	// returns the descriptors of both.
+	addArtefacts := func() (*artdesc.Descriptor, *artdesc.Descriptor) {
+		art := testhelper.NewArtefact(ns)
+		defer art.Close()
+		blob, err := ns.AddArtefact(art, testhelper.TAG)
+		Expect(err).To(Succeed())
+		subject := artdesc.DefaultBlobDescriptor(blob)
+
+		ref, err := ns.NewArtefact()
+		Expect(err).To(Succeed())
+		defer ref.Close()
+		_, err = ref.AddLayer(accessio.BlobAccessForString(mime.MIME_JSON, `{"sbom":"test"}`), nil)
+		Expect(err).To(Succeed())
+		config := accessio.BlobAccessForString(artdesc.MediaTypeEmptyJSON, "{}")
+		Expect(ns.AddBlob(config)).To(Succeed())
+		m, err := ref.Manifest()
+		Expect(err).To(Succeed())
+		m.Config = *artdesc.DefaultBlobDescriptor(config)
+		m.Subject = subject
+		m.ArtifactType = ARTIFACT_TYPE
+		blob, err = ns.AddArtefact(ref)
+		Expect(err).To(Succeed())
+		return subject, artdesc.DefaultBlobDescriptor(blob)
+	}
+
+	It("uses the referrers API", func() {
+		setup(true)
+		subject, referrer := addArtefacts()
+
+		list, err := ns.GetReferrers(subject.Digest, "")
+		Expect(err).To(Succeed())
+		Expect(len(list)).To(Equal(1))
+		Expect(list[0].Digest).To(Equal(referrer.Digest))
+		Expect(list[0].ArtifactType).To(Equal(ARTIFACT_TYPE))
+
+		list, err = ns.GetReferrers(subject.Digest, "other")
+		Expect(err).To(Succeed())
+		Expect(list).To(BeEmpty())
+
+		// no referrers tag is maintained for registries supporting the API
+		Expect(reg.Tags()).To(ConsistOf(NAMESPACE + ":" + testhelper.TAG))
+	})
+
+	It("falls back to the referrers tag schema", func() {
+		setup(false)
+		subject, referrer := addArtefacts()
+
+		Expect(reg.Tags()).To(ConsistOf(NAMESPACE+":"+testhelper.TAG, NAMESPACE+":"+artdesc.ReferrersTag(subject.Digest)))
+
+		list, err := ns.GetReferrers(subject.Digest, "")
+		Expect(err).To(Succeed())
+		Expect(len(list)).To(Equal(1))
+		Expect(list[0].Digest).To(Equal(referrer.Digest))
+		Expect(list[0].ArtifactType).To(Equal(ARTIFACT_TYPE))
+
+		list, err = ns.GetReferrers(subject.Digest, "other")
+		Expect(err).To(Succeed())
+		Expect(list).To(BeEmpty())
+	})
+
+	It("provides no referrers for unreferenced artefacts", func() {
+		setup(false)
+		list, err := ns.GetReferrers(digest.FromString("unknown"), "")
+		Expect(err).To(Succeed())
+		Expect(list).To(BeEmpty())
+	})
+})
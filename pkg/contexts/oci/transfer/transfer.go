@@ -15,10 +15,34 @@
 package transfer
 
 import (
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/cpi"
 	"github.com/open-component-model/ocm/pkg/errors"
 )
 
+// ReferrerSource is a source for artefacts referring to other artefacts.
+type ReferrerSource interface {
+	cpi.ArtefactSource
+	cpi.ReferrersLister
+}
+
+// Options describe optional aspects of an artefact transfer.
+type Options struct {
+	// Referrers, if set, is used to look up the artefacts referring
+	// to the transferred artefact (see OCI referrers API).
+	// They are transferred together with the artefact.
+	Referrers ReferrerSource
+	// Platforms, if set, restricts the transfer of an index to the
+	// manifests for the given platforms. If only a single manifest
+	// matches, this manifest is transferred instead of a reduced index.
+	Platforms []*artdesc.Platform
+}
+
 func TransferArtefact(art cpi.ArtefactAccess, set cpi.ArtefactSink, tags ...string) error {
 	if art.GetDescriptor().IsIndex() {
 		return TransferIndex(art.IndexAccess(), set, tags...)
@@ -27,6 +51,44 @@ func TransferArtefact(art cpi.ArtefactAccess, set cpi.ArtefactSink, tags ...stri
 	}
 }
 
+// TransferArtefactWithOptions transfers an artefact according to the given
+// options.
+// Referrers are always determined for the original artefact.
+func TransferArtefactWithOptions(art cpi.ArtefactAccess, set cpi.ArtefactSink, opts *Options, tags ...string) error {
+	var err error
+	if opts != nil && len(opts.Platforms) > 0 && art.GetDescriptor().IsIndex() {
+		_, err = TransferIndexForPlatforms(art.IndexAccess(), set, opts.Platforms, tags...)
+	} else {
+		err = TransferArtefact(art, set, tags...)
+	}
+	if err != nil || opts == nil || opts.Referrers == nil {
+		return err
+	}
+	return TransferReferrers(opts.Referrers, art.Digest(), set)
+}
+
+// TransferReferrers transfers all artefacts found in the given source
+// referring to the artefact with the given digest.
+// Referrers of referrers are transferred, also.
+func TransferReferrers(src ReferrerSource, d digest.Digest, set cpi.ArtefactSink) error {
+	list, err := src.GetReferrers(d, "")
+	if err != nil {
+		return errors.Wrapf(err, "getting referrers for %s", d)
+	}
+	for _, r := range list {
+		art, err := src.GetArtefact(r.Digest.String())
+		if err != nil {
+			return errors.Wrapf(err, "getting referrer %s", r.Digest)
+		}
+		err = TransferArtefactWithOptions(art, set, &Options{Referrers: src})
+		art.Close()
+		if err != nil {
+			return errors.Wrapf(err, "transferring referrer %s", r.Digest)
+		}
+	}
+	return nil
+}
+
 func TransferIndex(art cpi.IndexAccess, set cpi.ArtefactSink, tags ...string) error {
 	for _, l := range art.GetDescriptor().Manifests {
 		art, err := art.GetArtefact(l.Digest)
@@ -45,6 +107,52 @@ func TransferIndex(art cpi.IndexAccess, set cpi.ArtefactSink, tags ...string) er
 	return err
 }
 
+// TransferIndexForPlatforms transfers the manifests of an index matching
+// the given platforms. If several manifests match, a reduced index is
+// transferred. If only a single manifest matches, the manifest itself
+// is transferred. The digest of the transferred artefact is returned.
+func TransferIndexForPlatforms(art cpi.IndexAccess, set cpi.ArtefactSink, platforms []*artdesc.Platform, tags ...string) (digest.Digest, error) {
+	var selected []artdesc.Descriptor
+	for _, m := range art.GetDescriptor().Manifests {
+		if artdesc.MatchPlatform(m.Platform, platforms...) {
+			selected = append(selected, m)
+		}
+	}
+	if len(selected) == 0 {
+		list := []string{}
+		for _, p := range platforms {
+			list = append(list, artdesc.PlatformString(p))
+		}
+		return "", errors.Newf("no manifest found for platforms %s", strings.Join(list, ", "))
+	}
+	for _, l := range selected {
+		a, err := art.GetArtefact(l.Digest)
+		if err != nil {
+			return "", errors.Wrapf(err, "getting indexed artefact %s", l.Digest)
+		}
+		if len(selected) == 1 {
+			err = TransferArtefact(a, set, tags...)
+		} else {
+			err = TransferArtefact(a, set)
+		}
+		a.Close()
+		if err != nil {
+			return "", errors.Wrapf(err, "transferring indexed artefact %s", l.Digest)
+		}
+	}
+	if len(selected) == 1 {
+		return selected[0].Digest, nil
+	}
+
+	idx := *art.GetDescriptor()
+	idx.Manifests = selected
+	blob, err := set.AddArtefact(&indexArtefact{index: &idx}, tags...)
+	if err != nil {
+		return "", errors.Wrapf(err, "transferring index artefact")
+	}
+	return blob.Digest(), nil
+}
+
 func TransferManifest(art cpi.ManifestAccess, set cpi.ArtefactSink, tags ...string) error {
 	blob, err := art.GetConfigBlob()
 	if err != nil {
@@ -70,3 +178,46 @@ func TransferManifest(art cpi.ManifestAccess, set cpi.ArtefactSink, tags ...stri
 	}
 	return err
 }
+
+////////////////////////////////////////////////////////////////////////////////
+
+// indexArtefact provides an artefact for a plain index descriptor.
+type indexArtefact struct {
+	index *artdesc.Index
+}
+
+var _ cpi.Artefact = (*indexArtefact)(nil)
+
+func (a *indexArtefact) IsManifest() bool {
+	return false
+}
+
+func (a *indexArtefact) IsIndex() bool {
+	return true
+}
+
+func (a *indexArtefact) Digest() digest.Digest {
+	blob, err := a.Blob()
+	if err != nil {
+		return ""
+	}
+	return blob.Digest()
+}
+
+func (a *indexArtefact) Blob() (accessio.BlobAccess, error) {
+	return a.index.ToBlobAccess()
+}
+
+func (a *indexArtefact) Artefact() *artdesc.Artefact {
+	art := artdesc.New()
+	art.SetIndex(a.index)
+	return art
+}
+
+func (a *indexArtefact) Manifest() (*artdesc.Manifest, error) {
+	return nil, errors.ErrInvalid()
+}
+
+func (a *indexArtefact) Index() (*artdesc.Index, error) {
+	return a.index, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/git"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Type is the access type of a Git repository.
+const Type = "git"
+const TypeV1 = Type + runtime.VersionSeparator + "v1"
+
+func init() {
+	cpi.RegisterAccessType(cpi.NewAccessSpecType(Type, &AccessSpec{}))
+	cpi.RegisterAccessType(cpi.NewAccessSpecType(TypeV1, &AccessSpec{}))
+}
+
+// AccessSpec describes the access for the tree of a commit in a Git repository.
+type AccessSpec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+
+	// RepoURL is the URL of the Git repository.
+	RepoURL string `json:"repoUrl"`
+
+	// Ref is the reference (branch, tag or other ref) to use,
+	// if no commit is given.
+	// +optional
+	Ref string `json:"ref,omitempty"`
+
+	// Commit defines the hash of the commit.
+	// +optional
+	Commit string `json:"commit,omitempty"`
+
+	// Path is an optional path in the repository. If given,
+	// only the files below this path are provided.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+var _ cpi.AccessSpec = (*AccessSpec)(nil)
+
+// New creates a new Git repository access spec version v1.
+func New(repoURL, ref, commit, path string) *AccessSpec {
+	return &AccessSpec{
+		ObjectVersionedType: runtime.NewVersionedObjectType(Type),
+		RepoURL:             repoURL,
+		Ref:                 ref,
+		Commit:              commit,
+		Path:                path,
+	}
+}
+
+func (a *AccessSpec) Describe(ctx cpi.Context) string {
+	rev := a.Commit
+	if rev == "" {
+		rev = a.Ref
+	}
+	if a.Path != "" {
+		return fmt.Sprintf("Git repository %s[%s] path %s", a.RepoURL, rev, a.Path)
+	}
+	return fmt.Sprintf("Git repository %s[%s]", a.RepoURL, rev)
+}
+
+func (_ *AccessSpec) IsLocal(cpi.Context) bool {
+	return false
+}
+
+func (_ *AccessSpec) GetType() string {
+	return Type
+}
+
+func (a *AccessSpec) AccessMethod(c cpi.ComponentVersionAccess) (cpi.AccessMethod, error) {
+	return newMethod(c, a)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type accessMethod struct {
+	accessio.BlobAccess
+
+	comp cpi.ComponentVersionAccess
+	spec *AccessSpec
+}
+
+var _ cpi.AccessMethod = (*accessMethod)(nil)
+
+func newMethod(c cpi.ComponentVersionAccess, a *AccessSpec) (*accessMethod, error) {
+	if a.RepoURL == "" {
+		return nil, errors.ErrInvalid("git repository", "")
+	}
+
+	ctx := c.GetContext()
+	w := accessio.NewWriteAtWriter(func(w io.WriterAt) error {
+		repo, err := git.Open(ctx.CredentialsContext(), a.RepoURL)
+		if err != nil {
+			return err
+		}
+		commit, err := repo.Resolve(a.Ref, a.Commit)
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := git.WriteArchive(commit, a.Path, &buf); err != nil {
+			return err
+		}
+		_, err = w.WriteAt(buf.Bytes(), 0)
+		return err
+	})
+	return &accessMethod{
+		spec:       a,
+		comp:       c,
+		BlobAccess: accessobj.CachedBlobAccessForWriter(ctx, mime.MIME_TGZ, w),
+	}, nil
+}
+
+func (m *accessMethod) GetKind() string {
+	return Type
+}
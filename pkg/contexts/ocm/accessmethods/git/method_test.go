@@ -0,0 +1,114 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package git_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/env"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/git/testhelper"
+
+	me "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/git"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+func files(data []byte) map[string]string {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	Expect(err).To(Succeed())
+	tr := tar.NewReader(zr)
+	result := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).To(Succeed())
+		if hdr.Typeflag == tar.TypeReg {
+			content, err := io.ReadAll(tr)
+			Expect(err).To(Succeed())
+			result[hdr.Name] = string(content)
+		}
+	}
+	return result
+}
+
+var _ = Describe("Method", func() {
+	var env *Builder
+	var dir string
+	var commits []string
+
+	BeforeEach(func() {
+		var err error
+		env = NewBuilder(NewEnvironment())
+		dir, err = os.MkdirTemp("", "gitrepo-")
+		Expect(err).To(Succeed())
+		commits, err = CreateBareRepository(dir,
+			Commit{"README.md": "first\n"},
+			Commit{"README.md": "second\n", "src/main.go": "package main\n"},
+		)
+		Expect(err).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+		env.Cleanup()
+	})
+
+	get := func(spec *me.AccessSpec) ([]byte, error) {
+		m, err := spec.AccessMethod(&cpi.DummyComponentVersionAccess{Context: env.OCMContext()})
+		Expect(err).To(Succeed())
+		defer m.Close()
+		Expect(m.MimeType()).To(Equal(mime.MIME_TGZ))
+		return m.Get()
+	}
+
+	It("provides tree of default branch", func() {
+		data, err := get(me.New("file://"+dir, "", "", ""))
+		Expect(err).To(Succeed())
+		Expect(files(data)).To(Equal(map[string]string{
+			"README.md":   "second\n",
+			"src/main.go": "package main\n",
+		}))
+	})
+
+	It("provides tree of commit", func() {
+		data, err := get(me.New(dir, "", commits[0], ""))
+		Expect(err).To(Succeed())
+		Expect(files(data)).To(Equal(map[string]string{
+			"README.md": "first\n",
+		}))
+	})
+
+	It("provides filtered tree of tag", func() {
+		data, err := get(me.New(dir, "v2", "", "src"))
+		Expect(err).To(Succeed())
+		Expect(files(data)).To(Equal(map[string]string{
+			"src/main.go": "package main\n",
+		}))
+	})
+
+	It("fails for unknown ref", func() {
+		_, err := get(me.New(dir, "unknown", "", ""))
+		Expect(err).To(MatchError(ContainSubstring("git revision \"unknown\" not found")))
+	})
+})
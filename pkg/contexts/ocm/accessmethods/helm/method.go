@@ -0,0 +1,184 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package helm
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/helm"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Type is the access type of a Helm chart repository.
+const Type = "helm"
+const TypeV1 = Type + runtime.VersionSeparator + "v1"
+
+func init() {
+	cpi.RegisterAccessType(cpi.NewAccessSpecType(Type, &AccessSpec{}))
+	cpi.RegisterAccessType(cpi.NewAccessSpecType(TypeV1, &AccessSpec{}))
+}
+
+// AccessSpec describes the access for a helm chart in a Helm chart repository.
+type AccessSpec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+
+	// HelmRepository is the URL of the Helm chart repository.
+	// Charts stored in OCI registries are described by the scheme oci://.
+	HelmRepository string `json:"helmRepository"`
+
+	// HelmChart is the name of the chart with an optional version (<name>[:<version>]).
+	HelmChart string `json:"helmChart"`
+
+	// Version is the version of the chart, if not given as part of the chart name.
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+var (
+	_ cpi.AccessSpec   = (*AccessSpec)(nil)
+	_ cpi.HintProvider = (*AccessSpec)(nil)
+)
+
+// New creates a new Helm chart repository access spec version v1.
+func New(chart, version, repourl string) *AccessSpec {
+	return &AccessSpec{
+		ObjectVersionedType: runtime.NewVersionedObjectType(Type),
+		HelmRepository:      repourl,
+		HelmChart:           chart,
+		Version:             version,
+	}
+}
+
+func (a *AccessSpec) Describe(ctx cpi.Context) string {
+	return fmt.Sprintf("Helm chart %s in repository %s", a.GetChartName()+":"+a.GetVersion(), a.HelmRepository)
+}
+
+func (_ *AccessSpec) IsLocal(cpi.Context) bool {
+	return false
+}
+
+func (a *AccessSpec) GetReferenceHint(cv cpi.ComponentVersionAccess) string {
+	return a.GetChartName() + ":" + a.GetVersion()
+}
+
+func (_ *AccessSpec) GetType() string {
+	return Type
+}
+
+// GetChartName returns the name of the chart without version.
+func (a *AccessSpec) GetChartName() string {
+	if i := strings.LastIndex(a.HelmChart, ":"); i >= 0 {
+		return a.HelmChart[:i]
+	}
+	return a.HelmChart
+}
+
+// GetVersion returns the version of the chart.
+func (a *AccessSpec) GetVersion() string {
+	if a.Version != "" {
+		return a.Version
+	}
+	if i := strings.LastIndex(a.HelmChart, ":"); i >= 0 {
+		return a.HelmChart[i+1:]
+	}
+	return ""
+}
+
+func (a *AccessSpec) AccessMethod(c cpi.ComponentVersionAccess) (cpi.AccessMethod, error) {
+	return newMethod(c, a)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type accessMethod struct {
+	lock sync.Mutex
+	blob artefactset.ArtefactBlob
+	comp cpi.ComponentVersionAccess
+	spec *AccessSpec
+}
+
+var _ cpi.AccessMethod = (*accessMethod)(nil)
+
+func newMethod(c cpi.ComponentVersionAccess, a *AccessSpec) (*accessMethod, error) {
+	if a.HelmRepository == "" {
+		return nil, errors.ErrInvalid("helm repository", "")
+	}
+	if a.GetChartName() == "" {
+		return nil, errors.ErrInvalid("helm chart", a.HelmChart)
+	}
+	if a.GetVersion() == "" {
+		return nil, errors.Newf("version required for helm chart %s", a.GetChartName())
+	}
+	return &accessMethod{
+		spec: a,
+		comp: c,
+	}, nil
+}
+
+func (m *accessMethod) GetKind() string {
+	return Type
+}
+
+func (m *accessMethod) Close() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.blob != nil {
+		tmp := m.blob
+		m.blob = nil
+		return tmp.Close()
+	}
+	return nil
+}
+
+func (m *accessMethod) Get() ([]byte, error) {
+	blob, err := m.getBlob()
+	if err != nil {
+		return nil, err
+	}
+	return blob.Get()
+}
+
+func (m *accessMethod) Reader() (io.ReadCloser, error) {
+	blob, err := m.getBlob()
+	if err != nil {
+		return nil, err
+	}
+	return blob.Reader()
+}
+
+func (m *accessMethod) MimeType() string {
+	return artdesc.ToContentMediaType(artdesc.MediaTypeImageManifest) + artefactset.SynthesizedBlobFormat
+}
+
+func (m *accessMethod) getBlob() (artefactset.ArtefactBlob, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.blob != nil {
+		return m.blob, nil
+	}
+	blob, err := helm.SynthesizeArtefactBlob(m.comp.GetContext().OCIContext(), m.spec.HelmRepository, m.spec.GetChartName(), m.spec.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	m.blob = blob
+	return m.blob, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package helm_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/env"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/ociutils/helm"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
+	ctfoci "github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	helmaccess "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/helm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+)
+
+const OCIPATH = "/tmp/oci"
+const OCIHOST = "alias"
+
+// TESTCHART is the chart fixture shared with package pkg/helm.
+var TESTCHART = filepath.Join("..", "..", "..", "..", "helm", "testdata", "testchart")
+
+func checkChartBlob(m cpi.AccessMethod) {
+	Expect(m.MimeType()).To(Equal("application/vnd.oci.image.manifest.v1+tar+gzip"))
+	data, err := m.Get()
+	Expect(err).To(Succeed())
+	set, err := artefactset.OpenFromBlob(accessobj.ACC_READONLY, accessio.BlobAccessForData(m.MimeType(), data))
+	Expect(err).To(Succeed())
+	defer set.Close()
+	art, err := set.GetArtefact(set.GetMain().String())
+	Expect(err).To(Succeed())
+	defer art.Close()
+	Expect(art.ManifestAccess().GetDescriptor().Config.MediaType).To(Equal(registry.ConfigMediaType))
+	Expect(art.ManifestAccess().GetDescriptor().Layers[0].MediaType).To(Equal(registry.ChartLayerMediaType))
+}
+
+var _ = Describe("Method", func() {
+	var env *Builder
+
+	BeforeEach(func() {
+		env = NewBuilder(NewEnvironment())
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("accesses chart in helm repository", func() {
+		dir, err := os.MkdirTemp("", "helmrepo-")
+		Expect(err).To(Succeed())
+		defer os.RemoveAll(dir)
+		chart, err := loader.LoadDir(TESTCHART)
+		Expect(err).To(Succeed())
+		_, err = chartutil.Save(chart, dir)
+		Expect(err).To(Succeed())
+		index, err := repo.IndexDirectory(dir, "")
+		Expect(err).To(Succeed())
+		Expect(index.WriteFile(filepath.Join(dir, "index.yaml"), 0o600)).To(Succeed())
+		server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+		defer server.Close()
+
+		spec := helmaccess.New("testchart:0.1.0", "", server.URL)
+		m, err := spec.AccessMethod(&cpi.DummyComponentVersionAccess{Context: env.OCMContext()})
+		Expect(err).To(Succeed())
+		defer m.Close()
+		Expect(spec.GetReferenceHint(nil)).To(Equal("testchart:0.1.0"))
+		checkChartBlob(m)
+	})
+
+	It("accesses chart in oci registry", func() {
+		env.OCICommonTransport(OCIPATH, accessio.FormatDirectory)
+		repo, err := ctfoci.Open(env.OCIContext(), accessobj.ACC_WRITABLE, OCIPATH, 0, env)
+		Expect(err).To(Succeed())
+		ns, err := repo.LookupNamespace("charts/testchart")
+		Expect(err).To(Succeed())
+		_, desc, err := helm.TransferAsArtefact(TESTCHART, ns, osfs.New())
+		Expect(err).To(Succeed())
+		Expect(ns.AddTags(desc.Digest, "0.1.0")).To(Succeed())
+		Expect(ns.Close()).To(Succeed())
+		Expect(repo.Close()).To(Succeed())
+
+		env.OCIContext().SetAlias(OCIHOST, ctfoci.NewRepositorySpec(accessobj.ACC_READONLY, OCIPATH, accessio.PathFileSystem(env.FileSystem())))
+
+		spec := helmaccess.New("testchart", "0.1.0", "oci://"+OCIHOST+".alias/charts")
+		m, err := spec.AccessMethod(&cpi.DummyComponentVersionAccess{Context: env.OCMContext()})
+		Expect(err).To(Succeed())
+		defer m.Close()
+		checkChartBlob(m)
+	})
+
+	It("requires a version", func() {
+		_, err := helmaccess.New("testchart", "", "https://charts.example.com").AccessMethod(&cpi.DummyComponentVersionAccess{Context: env.OCMContext()})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,66 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package identity
+
+import (
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/identity/hostpath"
+)
+
+// CONSUMER_TYPE is the type of an HTTP(S) server providing blobs.
+const CONSUMER_TYPE = "HTTP"
+
+// ID_HOSTNAME is the hostname of an HTTP(S) server.
+const ID_HOSTNAME = hostpath.ID_HOSTNAME
+
+// ID_PORT is the port number of an HTTP(S) server.
+const ID_PORT = hostpath.ID_PORT
+
+// ID_PATHPREFIX is the path of a blob URL.
+const ID_PATHPREFIX = hostpath.ID_PATHPREFIX
+
+func init() {
+	cpi.RegisterStandardIdentityMatcher(CONSUMER_TYPE, IdentityMatcher, `HTTP(S) server credential matcher
+
+It matches the <code>`+CONSUMER_TYPE+`</code> consumer type and additionally acts like 
+the <code>`+hostpath.IDENTITY_TYPE+`</code> type. It is used by the
+<code>http</code> access method and the <code>wget</code> input type.
+Supported credential attributes are <code>`+credentials.ATTR_TOKEN+`</code>
+or <code>`+credentials.ATTR_USERNAME+`</code> and <code>`+credentials.ATTR_PASSWORD+`</code>.`)
+}
+
+var identityMatcher = hostpath.IdentityMatcher(CONSUMER_TYPE)
+
+func IdentityMatcher(pattern, cur, id cpi.ConsumerIdentity) bool {
+	return identityMatcher(pattern, cur, id)
+}
+
+// GetConsumerId returns the consumer id for the given
+// HTTP(S) URL.
+func GetConsumerId(rawurl string) (credentials.ConsumerIdentity, error) {
+	return hostpath.ConsumerIdentityForURL(CONSUMER_TYPE, rawurl)
+}
+
+// GetCredentials returns the credentials configured for the given
+// HTTP(S) URL. If no credentials are configured, nil
+// is returned.
+func GetCredentials(cctx credentials.Context, rawurl string) (credentials.Credentials, error) {
+	id, err := GetConsumerId(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	return hostpath.GetCredentials(cctx, id)
+}
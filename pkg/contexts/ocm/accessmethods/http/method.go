@@ -0,0 +1,187 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package http
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	hd "github.com/open-component-model/ocm/pkg/common/accessio/downloader/http"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/http/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Type is the access type for a blob provided by an HTTP(S) URL.
+const Type = "http"
+const TypeV1 = Type + runtime.VersionSeparator + "v1"
+
+func init() {
+	cpi.RegisterAccessType(cpi.NewAccessSpecType(Type, &AccessSpec{}))
+	cpi.RegisterAccessType(cpi.NewAccessSpecType(TypeV1, &AccessSpec{}))
+}
+
+// AccessSpec describes the access for a blob provided by an HTTP(S) URL.
+type AccessSpec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+
+	// URL is the HTTP(S) URL of the blob.
+	URL string `json:"url"`
+	// MediaType defines the mime type of the blob.
+	// +optional
+	MediaType string `json:"mediaType,omitempty"`
+	// Digest is the expected digest of the blob (<algorithm>:<hex>).
+	// If given, the download fails if the content does not match.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+}
+
+var _ cpi.AccessSpec = (*AccessSpec)(nil)
+
+// New creates a new HTTP access spec version v1.
+func New(url, mediaType, digest string) *AccessSpec {
+	return &AccessSpec{
+		ObjectVersionedType: runtime.NewVersionedObjectType(Type),
+		URL:                 url,
+		MediaType:           mediaType,
+		Digest:              digest,
+	}
+}
+
+func (a *AccessSpec) Describe(ctx cpi.Context) string {
+	return fmt.Sprintf("HTTP URL %s", a.URL)
+}
+
+func (_ *AccessSpec) IsLocal(cpi.Context) bool {
+	return false
+}
+
+func (_ *AccessSpec) GetType() string {
+	return Type
+}
+
+func (a *AccessSpec) AccessMethod(c cpi.ComponentVersionAccess) (cpi.AccessMethod, error) {
+	return newMethod(c, a)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type accessMethod struct {
+	accessio.BlobAccess
+
+	lock     sync.Mutex
+	verified bool
+	comp     cpi.ComponentVersionAccess
+	spec     *AccessSpec
+}
+
+var _ cpi.AccessMethod = (*accessMethod)(nil)
+
+func newMethod(c cpi.ComponentVersionAccess, a *AccessSpec) (*accessMethod, error) {
+	u, err := url.Parse(a.URL)
+	if err != nil {
+		return nil, errors.ErrInvalidWrap(err, "url", a.URL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.ErrInvalid("url", a.URL)
+	}
+	if a.Digest != "" {
+		if _, err := digest.Parse(a.Digest); err != nil {
+			return nil, errors.ErrInvalidWrap(err, "digest", a.Digest)
+		}
+	}
+
+	creds, err := identity.GetCredentials(c.GetContext().CredentialsContext(), a.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get creds: %w", err)
+	}
+	d := hd.NewDownloader(a.URL, DownloaderOptions(creds)...)
+	w := accessio.NewWriteAtWriter(d.Download)
+	mediaType := a.MediaType
+	if mediaType == "" {
+		mediaType = mime.MIME_OCTET
+	}
+	return &accessMethod{
+		spec:       a,
+		comp:       c,
+		BlobAccess: accessobj.CachedBlobAccessForWriter(c.GetContext(), mediaType, w),
+	}, nil
+}
+
+// DownloaderOptions provides the options for an HTTP downloader
+// authenticating with the given credentials.
+func DownloaderOptions(creds credentials.Credentials) []hd.Option {
+	var opts []hd.Option
+	if creds != nil {
+		if token := creds.GetProperty(credentials.ATTR_TOKEN); token != "" {
+			opts = append(opts, hd.WithToken(token))
+		} else if user := creds.GetProperty(credentials.ATTR_USERNAME); user != "" {
+			opts = append(opts, hd.WithBasicAuth(user, creds.GetProperty(credentials.ATTR_PASSWORD)))
+		}
+	}
+	return opts
+}
+
+func (m *accessMethod) GetKind() string {
+	return Type
+}
+
+func (m *accessMethod) Get() ([]byte, error) {
+	if err := m.verify(); err != nil {
+		return nil, err
+	}
+	return m.BlobAccess.Get()
+}
+
+func (m *accessMethod) Reader() (io.ReadCloser, error) {
+	if err := m.verify(); err != nil {
+		return nil, err
+	}
+	return m.BlobAccess.Reader()
+}
+
+// verify checks the downloaded content against the expected digest.
+func (m *accessMethod) verify() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.verified || m.spec.Digest == "" {
+		return nil
+	}
+	expected := digest.Digest(m.spec.Digest)
+	r, err := m.BlobAccess.Reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	d, err := expected.Algorithm().FromReader(r)
+	if err != nil {
+		return err
+	}
+	if d != expected {
+		return errors.Newf("digest mismatch for %s: expected %s, found %s", m.spec.URL, expected, d)
+	}
+	m.verified = true
+	return nil
+}
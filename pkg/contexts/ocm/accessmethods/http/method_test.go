@@ -0,0 +1,136 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/env"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	me "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/http"
+	httpidentity "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/http/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const CONTENT = "this is some test content"
+
+var _ = Describe("Method", func() {
+	var env *Builder
+	var server *httptest.Server
+	var auth bool
+
+	BeforeEach(func() {
+		env = NewBuilder(NewEnvironment())
+		auth = false
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if auth {
+				if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+			}
+			if r.URL.Path != "/data" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(CONTENT))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		env.Cleanup()
+	})
+
+	It("downloads content", func() {
+		spec := me.New(server.URL+"/data", mime.MIME_TEXT, "")
+		m, err := spec.AccessMethod(&cpi.DummyComponentVersionAccess{Context: env.OCMContext()})
+		Expect(err).To(Succeed())
+		defer m.Close()
+		Expect(m.MimeType()).To(Equal(mime.MIME_TEXT))
+		data, err := m.Get()
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(Equal(CONTENT))
+	})
+
+	It("verifies digest", func() {
+		spec := me.New(server.URL+"/data", "", digest.FromString(CONTENT).String())
+		m, err := spec.AccessMethod(&cpi.DummyComponentVersionAccess{Context: env.OCMContext()})
+		Expect(err).To(Succeed())
+		defer m.Close()
+		Expect(m.MimeType()).To(Equal(mime.MIME_OCTET))
+		data, err := m.Get()
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(Equal(CONTENT))
+	})
+
+	It("fails for digest mismatch", func() {
+		spec := me.New(server.URL+"/data", "", digest.FromString("other").String())
+		m, err := spec.AccessMethod(&cpi.DummyComponentVersionAccess{Context: env.OCMContext()})
+		Expect(err).To(Succeed())
+		defer m.Close()
+		_, err = m.Get()
+		Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+		_, err = m.Reader()
+		Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+	})
+
+	It("fails for missing content", func() {
+		spec := me.New(server.URL+"/other", "", "")
+		m, err := spec.AccessMethod(&cpi.DummyComponentVersionAccess{Context: env.OCMContext()})
+		Expect(err).To(Succeed())
+		defer m.Close()
+		_, err = m.Get()
+		Expect(err).To(MatchError(ContainSubstring("404")))
+	})
+
+	It("uses credentials", func() {
+		auth = true
+		u, err := url.Parse(server.URL)
+		Expect(err).To(Succeed())
+		id := credentials.ConsumerIdentity{
+			credentials.CONSUMER_ATTR_TYPE: httpidentity.CONSUMER_TYPE,
+			httpidentity.ID_HOSTNAME:       u.Hostname(),
+			httpidentity.ID_PORT:           u.Port(),
+		}
+		env.CredentialsContext().SetCredentialsForConsumer(id, credentials.NewCredentials(common.Properties{
+			credentials.ATTR_USERNAME: "user",
+			credentials.ATTR_PASSWORD: "pass",
+		}))
+
+		spec := me.New(server.URL+"/data", "", "")
+		m, err := spec.AccessMethod(&cpi.DummyComponentVersionAccess{Context: env.OCMContext()})
+		Expect(err).To(Succeed())
+		defer m.Close()
+		data, err := m.Get()
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(Equal(CONTENT))
+	})
+
+	It("rejects invalid digest", func() {
+		_, err := me.New(server.URL+"/data", "", "sha256:xyz").AccessMethod(&cpi.DummyComponentVersionAccess{Context: env.OCMContext()})
+		Expect(err).To(HaveOccurred())
+	})
+})
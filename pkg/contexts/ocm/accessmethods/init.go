@@ -15,10 +15,14 @@
 package accessmethods
 
 import (
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/git"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/github"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/helm"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/http"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localblob"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localfsblob"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localociblob"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/maven"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/none"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartefact"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociblob"
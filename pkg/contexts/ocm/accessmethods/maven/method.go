@@ -0,0 +1,140 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package maven
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/maven"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Type is the access type of a Maven repository.
+const Type = "maven"
+const TypeV1 = Type + runtime.VersionSeparator + "v1"
+
+func init() {
+	cpi.RegisterAccessType(cpi.NewAccessSpecType(Type, &AccessSpec{}))
+	cpi.RegisterAccessType(cpi.NewAccessSpecType(TypeV1, &AccessSpec{}))
+}
+
+// AccessSpec describes the access for a file of an artifact in a Maven repository.
+type AccessSpec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+
+	// Repository is the URL of the Maven repository.
+	// Local directories with a Maven repository layout are described by the scheme file://.
+	Repository string `json:"repository"`
+	// GroupId is the group id of the artifact.
+	GroupId string `json:"groupId"`
+	// ArtifactId is the id of the artifact.
+	ArtifactId string `json:"artifactId"`
+	// Version is the version of the artifact.
+	Version string `json:"version"`
+	// Classifier is the optional classifier of the artifact file.
+	// +optional
+	Classifier string `json:"classifier,omitempty"`
+	// Extension is the file extension of the artifact file (default jar).
+	// +optional
+	Extension string `json:"extension,omitempty"`
+}
+
+var _ cpi.AccessSpec = (*AccessSpec)(nil)
+
+// New creates a new Maven repository access spec version v1.
+func New(repository, groupId, artifactId, version, classifier, extension string) *AccessSpec {
+	return &AccessSpec{
+		ObjectVersionedType: runtime.NewVersionedObjectType(Type),
+		Repository:          repository,
+		GroupId:             groupId,
+		ArtifactId:          artifactId,
+		Version:             version,
+		Classifier:          classifier,
+		Extension:           extension,
+	}
+}
+
+func (a *AccessSpec) Describe(ctx cpi.Context) string {
+	return fmt.Sprintf("Maven artifact %s in repository %s", a.Coordinates(), a.Repository)
+}
+
+func (_ *AccessSpec) IsLocal(cpi.Context) bool {
+	return false
+}
+
+func (_ *AccessSpec) GetType() string {
+	return Type
+}
+
+// Coordinates returns the Maven coordinates of the described artifact file.
+func (a *AccessSpec) Coordinates() *maven.Coordinates {
+	return &maven.Coordinates{
+		GroupId:    a.GroupId,
+		ArtifactId: a.ArtifactId,
+		Version:    a.Version,
+		Classifier: a.Classifier,
+		Extension:  a.Extension,
+	}
+}
+
+func (a *AccessSpec) AccessMethod(c cpi.ComponentVersionAccess) (cpi.AccessMethod, error) {
+	return newMethod(c, a)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type accessMethod struct {
+	accessio.BlobAccess
+
+	comp cpi.ComponentVersionAccess
+	spec *AccessSpec
+}
+
+var _ cpi.AccessMethod = (*accessMethod)(nil)
+
+func newMethod(c cpi.ComponentVersionAccess, a *AccessSpec) (*accessMethod, error) {
+	if a.Repository == "" {
+		return nil, errors.ErrInvalid("maven repository", "")
+	}
+	coords := a.Coordinates()
+	if err := coords.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := c.GetContext()
+	w := accessio.NewWriteAtWriter(func(w io.WriterAt) error {
+		data, err := maven.Download(vfsattr.Get(ctx), ctx.CredentialsContext(), a.Repository, coords)
+		if err != nil {
+			return err
+		}
+		_, err = w.WriteAt(data, 0)
+		return err
+	})
+	return &accessMethod{
+		spec:       a,
+		comp:       c,
+		BlobAccess: accessobj.CachedBlobAccessForWriter(ctx, coords.MimeType(), w),
+	}, nil
+}
+
+func (m *accessMethod) GetKind() string {
+	return Type
+}
@@ -0,0 +1,213 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package maven_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/env"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localblob"
+	me "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/maven"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+	"github.com/open-component-model/ocm/pkg/maven/identity"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const REPO = "file:///testdata/repo"
+const GROUP = "com.example"
+
+const ARCH = "/tmp/ctf"
+const OUT = "/tmp/res"
+const COMPONENT = "github.com/mandelsoft/test"
+const VERSION = "v1"
+
+var _ = Describe("Method", func() {
+	var env *Builder
+
+	BeforeEach(func() {
+		env = NewBuilder(NewEnvironment(TestData()))
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	get := func(spec *me.AccessSpec) ([]byte, string, error) {
+		m, err := spec.AccessMethod(&cpi.DummyComponentVersionAccess{Context: env.OCMContext()})
+		if err != nil {
+			return nil, "", err
+		}
+		defer m.Close()
+		data, err := m.Get()
+		return data, m.MimeType(), err
+	}
+
+	It("accesses jar in local repository", func() {
+		data, mimeType, err := get(me.New(REPO, GROUP, "hello", "1.0.0", "", ""))
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(Equal("this is a test jar\n"))
+		Expect(mimeType).To(Equal(mime.MIME_JAR))
+	})
+
+	It("accesses classified jar", func() {
+		data, _, err := get(me.New(REPO, GROUP, "hello", "1.0.0", "sources", "jar"))
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(Equal("these are test sources\n"))
+	})
+
+	It("accesses file with other extension", func() {
+		data, mimeType, err := get(me.New(REPO, GROUP, "hello", "1.0.0", "", "pom"))
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(Equal("<project/>\n"))
+		Expect(mimeType).To(Equal(mime.MIME_XML))
+	})
+
+	It("fails for checksum mismatch", func() {
+		_, _, err := get(me.New(REPO, GROUP, "broken", "1.0.0", "", ""))
+		Expect(err).To(MatchError(ContainSubstring("sha1 checksum mismatch for com.example:broken:1.0.0:jar")))
+	})
+
+	It("fails for missing checksum", func() {
+		_, _, err := get(me.New(REPO, GROUP, "unchecked", "1.0.0", "", ""))
+		Expect(err).To(MatchError(ContainSubstring("no sha512, sha256 or sha1 checksum found for com.example:unchecked:1.0.0:jar in repository " + REPO)))
+	})
+
+	It("fails for md5 checksum only", func() {
+		_, _, err := get(me.New(REPO, GROUP, "weak", "1.0.0", "", ""))
+		Expect(err).To(MatchError(ContainSubstring("no sha512, sha256 or sha1 checksum found for com.example:weak:1.0.0:jar in repository " + REPO)))
+	})
+
+	It("fails for missing artifact", func() {
+		_, _, err := get(me.New(REPO, GROUP, "hello", "2.0.0", "", ""))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("com.example:hello:2.0.0:jar"))
+		Expect(err.Error()).To(ContainSubstring("not found"))
+	})
+
+	It("rejects incomplete coordinates", func() {
+		_, _, err := get(me.New(REPO, GROUP, "hello", "", "", ""))
+		Expect(err).To(MatchError("version required for maven artifact com.example:hello"))
+	})
+
+	Context("remote repository", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			files := http.FileServer(http.Dir("testdata/repo"))
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				files.ServeHTTP(w, r)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		setCredentials := func() {
+			id, err := identity.GetConsumerId(server.URL)
+			Expect(err).To(Succeed())
+			env.CredentialsContext().SetCredentialsForConsumer(id, credentials.NewCredentials(common.Properties{
+				credentials.ATTR_USERNAME: "user",
+				credentials.ATTR_PASSWORD: "pass",
+			}))
+		}
+
+		It("uses credentials", func() {
+			setCredentials()
+			data, _, err := get(me.New(server.URL, GROUP, "hello", "1.0.0", "", ""))
+			Expect(err).To(Succeed())
+			Expect(string(data)).To(Equal("this is a test jar\n"))
+		})
+
+		It("fails for missing checksum", func() {
+			setCredentials()
+			_, _, err := get(me.New(server.URL, GROUP, "unchecked", "1.0.0", "", ""))
+			Expect(err).To(MatchError(ContainSubstring("no sha512, sha256 or sha1 checksum found for com.example:unchecked:1.0.0:jar in repository " + server.URL)))
+		})
+
+		It("fails for missing artifact", func() {
+			setCredentials()
+			_, _, err := get(me.New(server.URL, GROUP, "hello", "2.0.0", "", ""))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("com.example:hello:2.0.0:jar"))
+			Expect(err.Error()).To(ContainSubstring("not found"))
+		})
+
+		It("fails without credentials", func() {
+			_, _, err := get(me.New(server.URL, GROUP, "hello", "1.0.0", "", ""))
+			Expect(err).To(MatchError(ContainSubstring("401")))
+		})
+	})
+
+	It("transfers jar by value", func() {
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Component(COMPONENT, func() {
+				env.Version(VERSION, func() {
+					env.Provider("mandelsoft")
+					env.Resource("jar", "1.0.0", "jar", metav1.ExternalRelation, func() {
+						env.Access(me.New(REPO, GROUP, "hello", "1.0.0", "", ""))
+					})
+				})
+			})
+		})
+
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		defer src.Close()
+		cv, err := src.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer cv.Close()
+		tgt, err := ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env)
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+		handler, err := standard.New(standard.ResourcesByValue())
+		Expect(err).To(Succeed())
+		Expect(transfer.TransferVersion(nil, nil, cv, tgt, handler)).To(Succeed())
+
+		comp, err := tgt.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer comp.Close()
+		r, err := comp.GetResourceByIndex(0)
+		Expect(err).To(Succeed())
+		spec, err := r.Access()
+		Expect(err).To(Succeed())
+		Expect(spec.GetKind()).To(Equal(localblob.Type))
+		Expect(spec.(*localblob.AccessSpec).MediaType).To(Equal(mime.MIME_JAR))
+
+		m, err := r.AccessMethod()
+		Expect(err).To(Succeed())
+		defer m.Close()
+		data, err := m.Get()
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(Equal("this is a test jar\n"))
+	})
+})
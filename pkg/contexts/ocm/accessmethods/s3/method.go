@@ -61,7 +61,10 @@ type AccessSpec struct {
 	Version string `json:"version,omitempty"`
 	// MediaType defines the mime type of the object to download.
 	// +optional
-	MediaType  string `json:"mediaType,omitempty"`
+	MediaType string `json:"mediaType,omitempty"`
+	// Endpoint is the URL of an S3 compatible store to use instead of AWS.
+	// +optional
+	Endpoint   string `json:"endpoint,omitempty"`
 	downloader downloader.Downloader
 }
 
@@ -108,27 +111,13 @@ type accessMethod struct {
 var _ cpi.AccessMethod = (*accessMethod)(nil)
 
 func newMethod(c cpi.ComponentVersionAccess, a *AccessSpec) (*accessMethod, error) {
-	creds, err := getCreds(a, c.GetContext().CredentialsContext())
+	creds, err := GetCredentials(c.GetContext().CredentialsContext(), a.Bucket, a.Key, a.Version)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get creds: %w", err)
 	}
 
-	var (
-		accessKeyID  string
-		accessSecret string
-	)
-	if creds != nil {
-		accessKeyID = creds.GetProperty(credentials.ATTR_AWS_ACCESS_KEY_ID)
-		accessSecret = creds.GetProperty(credentials.ATTR_AWS_SECRET_ACCESS_KEY)
-	}
-	var awsCreds *s3.AWSCreds
-	if accessKeyID != "" {
-		awsCreds = &s3.AWSCreds{
-			AccessKeyID:  accessKeyID,
-			AccessSecret: accessSecret,
-		}
-	}
-	var d downloader.Downloader = s3.NewDownloader(a.Region, a.Bucket, a.Key, a.Version, awsCreds)
+	awsCreds := AWSCreds(creds)
+	var d downloader.Downloader = s3.NewDownloader(a.Region, a.Bucket, a.Key, a.Version, awsCreds).WithEndpoint(a.Endpoint)
 	if a.downloader != nil {
 		d = a.downloader
 	}
@@ -146,15 +135,29 @@ func newMethod(c cpi.ComponentVersionAccess, a *AccessSpec) (*accessMethod, erro
 	}, nil
 }
 
-func getCreds(a *AccessSpec, cctx credentials.Context) (credentials.Credentials, error) {
+// AWSCreds provides the AWS credentials from the given credentials.
+// If no access key is given, nil is returned.
+func AWSCreds(creds credentials.Credentials) *s3.AWSCreds {
+	if creds == nil || creds.GetProperty(credentials.ATTR_AWS_ACCESS_KEY_ID) == "" {
+		return nil
+	}
+	return &s3.AWSCreds{
+		AccessKeyID:  creds.GetProperty(credentials.ATTR_AWS_ACCESS_KEY_ID),
+		AccessSecret: creds.GetProperty(credentials.ATTR_AWS_SECRET_ACCESS_KEY),
+	}
+}
+
+// GetCredentials returns the credentials configured for an object in a bucket.
+// If no credentials are configured, nil is returned.
+func GetCredentials(cctx credentials.Context, bucket, key, version string) (credentials.Credentials, error) {
 	id := credentials.ConsumerIdentity{
 		credentials.CONSUMER_ATTR_TYPE: CONSUMER_TYPE,
-		identity.ID_HOSTNAME:           a.Bucket,
+		identity.ID_HOSTNAME:           bucket,
 	}
-	if a.Version != "" {
-		id[identity.ID_PORT] = a.Version
+	if version != "" {
+		id[identity.ID_PORT] = version
 	}
-	id[identity.ID_PATHPREFIX] = path.Join(a.Bucket, a.Key, a.Version)
+	id[identity.ID_PATHPREFIX] = path.Join(bucket, key, version)
 	var creds credentials.Credentials
 	src, err := cctx.GetCredentialsForConsumer(id, hostpath.IdentityMatcher(CONSUMER_TYPE))
 	if err != nil {
@@ -18,5 +18,8 @@ import (
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/compatattr"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/keepblobattr"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/ociuploadattr"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/reproducibleattr"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/s3uploadattr"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verifyattr"
 )
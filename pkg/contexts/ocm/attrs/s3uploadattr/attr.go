@@ -0,0 +1,122 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package s3uploadattr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const (
+	ATTR_KEY   = "github.com/mandelsoft/ocm/s3upload"
+	ATTR_SHORT = "s3upload"
+)
+
+func init() {
+	datacontext.RegisterAttributeType(ATTR_KEY, AttributeType{}, ATTR_SHORT)
+}
+
+type AttributeType struct{}
+
+func (a AttributeType) Name() string {
+	return ATTR_KEY
+}
+
+func (a AttributeType) Description() string {
+	return `
+*S3 upload specification*
+Upload local blobs with dedicated media types to an S3 bucket.
+The value is a JSON/YAML object with the fields
+<code>bucket</code>, <code>prefix</code> (optional), <code>region</code>
+(optional), <code>endpoint</code> (optional, for S3 compatible stores) and
+<code>mediaTypes</code> (list of media types to upload). A media type
+ending with <code>/*</code> matches all media types with the given prefix.
+`
+}
+
+func (a AttributeType) Encode(v interface{}, marshaller runtime.Marshaler) ([]byte, error) {
+	if _, ok := v.(*Attribute); !ok {
+		return nil, fmt.Errorf("S3 Upload Attribute structure required")
+	}
+	return marshaller.Marshal(v)
+}
+
+func (a AttributeType) Decode(data []byte, unmarshaller runtime.Unmarshaler) (interface{}, error) {
+	var value Attribute
+	err := unmarshaller.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+	if value.Bucket == "" {
+		return nil, errors.ErrInvalidWrap(errors.Newf("missing bucket"), "s3 upload specification", string(data))
+	}
+	if len(value.MediaTypes) == 0 {
+		return nil, errors.ErrInvalidWrap(errors.Newf("missing media types"), "s3 upload specification", string(data))
+	}
+	return &value, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type Attribute struct {
+	// Bucket is the S3 bucket to upload to.
+	Bucket string `json:"bucket"`
+	// Prefix is an optional key prefix for the uploaded objects.
+	Prefix string `json:"prefix,omitempty"`
+	// Region is the region of the bucket.
+	Region string `json:"region,omitempty"`
+	// Endpoint is the URL of an S3 compatible store to use instead of AWS.
+	Endpoint string `json:"endpoint,omitempty"`
+	// MediaTypes are the media types of the blobs to upload.
+	MediaTypes []string `json:"mediaTypes"`
+}
+
+func New(bucket, prefix string, mediaTypes ...string) *Attribute {
+	return &Attribute{Bucket: bucket, Prefix: prefix, MediaTypes: mediaTypes}
+}
+
+// Matches checks whether a blob with the given media type should be uploaded.
+func (a *Attribute) Matches(mediaType string) bool {
+	if i := strings.Index(mediaType, ";"); i >= 0 {
+		mediaType = strings.TrimSpace(mediaType[:i])
+	}
+	for _, m := range a.MediaTypes {
+		if m == mediaType {
+			return true
+		}
+		if strings.HasSuffix(m, "/*") && strings.HasPrefix(mediaType, m[:len(m)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Get(ctx datacontext.Context) *Attribute {
+	a := ctx.GetAttributes().GetAttribute(ATTR_KEY)
+	if a == nil {
+		return nil
+	}
+	return a.(*Attribute)
+}
+
+func Set(ctx datacontext.Context, attr *Attribute) error {
+	return ctx.GetAttributes().SetAttribute(ATTR_KEY, attr)
+}
@@ -15,6 +15,7 @@
 package signingattr
 
 import (
+	"crypto"
 	"encoding/base64"
 	"encoding/json"
 
@@ -68,6 +69,7 @@ type KeySpec struct {
 	Data       RawData        `json:"data,omitempty"`
 	StringData string         `json:"stringdata,omitempty"`
 	Path       string         `json:"path,omitempty"`
+	Ref        string         `json:"ref,omitempty"`
 	Parsed     interface{}    `json:"-"`
 	FileSystem vfs.FileSystem `json:"-"`
 }
@@ -76,6 +78,12 @@ func (k *KeySpec) Get() (interface{}, error) {
 	if k.Parsed != nil {
 		return k.Parsed, nil
 	}
+	if k.Ref != "" {
+		if k.Data != nil || k.StringData != "" || k.Path != "" {
+			return nil, errors.Newf("only one of data, stringdata, path or ref may be set")
+		}
+		return signing.DefaultKeyProviderRegistry().ResolveKeyReference(k.Ref)
+	}
 	if k.Data != nil {
 		if k.StringData != "" || k.Path != "" {
 			return nil, errors.Newf("only one of data, stringdata or path may be set")
@@ -171,6 +179,9 @@ func (a *Config) ApplyToRegistry(registry signing.KeyRegistry) error {
 		if err != nil {
 			return errors.Wrapf(err, "cannot get public key %s", n)
 		}
+		if s, ok := key.(crypto.Signer); ok && k.Ref != "" {
+			key = s.Public()
+		}
 		registry.RegisterPublicKey(n, key)
 	}
 	for n, k := range a.PrivateKeys {
@@ -189,6 +200,9 @@ public and private keys. A key value might be given by one of the fields:
 - <code>path</code>: path of file with key data
 - <code>data</code>: base64 encoded binary data
 - <code>stringdata</code>: data a string parsed by key handler
+- <code>ref</code>: key reference for a key kept in an external key store
+  (for example <code>pkcs11:...</code> or <code>exec:...</code>). The key
+  material is not exported, it is only used to sign digests.
 
 <pre>
     type: ` + ConfigType + `
@@ -0,0 +1,92 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package verifyattr
+
+import (
+	"fmt"
+
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const (
+	ATTR_KEY   = "github.com/mandelsoft/ocm/verification"
+	ATTR_SHORT = "verification"
+)
+
+func init() {
+	datacontext.RegisterAttributeType(ATTR_KEY, AttributeType{}, ATTR_SHORT)
+}
+
+type AttributeType struct{}
+
+func (a AttributeType) Name() string {
+	return ATTR_KEY
+}
+
+func (a AttributeType) Description() string {
+	return `
+*JSON*
+Verification policy for component versions given as JSON document with the
+following format:
+
+<pre>
+{
+  "rules": [
+    {
+      "components": [ "&lt;component name pattern>" ],
+      "requiredSignatures": [ "&lt;signature name>" ],
+      "trustedIssuers": [ "&lt;issuer pattern>" ],
+      "minSignatures": &lt;number>,
+      "verifyReferences": [ "&lt;component name pattern>" ]
+    }
+  ]
+}
+</pre>
+
+The policy is enforced for all signature verifications of component
+versions.
+`
+}
+
+func (a AttributeType) Encode(v interface{}, marshaller runtime.Marshaler) ([]byte, error) {
+	if _, ok := v.(*Policy); !ok {
+		return nil, fmt.Errorf("verification policy required")
+	}
+	return marshaller.Marshal(v)
+}
+
+func (a AttributeType) Decode(data []byte, unmarshaller runtime.Unmarshaler) (interface{}, error) {
+	var value Policy
+	err := unmarshaller.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func Get(ctx datacontext.Context) *Policy {
+	a := ctx.GetAttributes().GetAttribute(ATTR_KEY)
+	if a == nil {
+		return nil
+	}
+	return a.(*Policy)
+}
+
+func Set(ctx datacontext.Context, policy *Policy) error {
+	return ctx.GetAttributes().SetAttribute(ATTR_KEY, policy)
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package verifyattr_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/contexts/config"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verifyattr"
+)
+
+const COMPONENT = "github.com/mandelsoft/test"
+
+var _ = Describe("attribute", func() {
+	var cfgctx config.Context
+
+	BeforeEach(func() {
+		cfgctx = config.WithSharedAttributes(datacontext.New(nil)).New()
+	})
+
+	It("marshal/unmarshal", func() {
+		cfg := verifyattr.New()
+		cfg.AddRule(verifyattr.Rule{
+			Components:         []string{"github.com/mandelsoft/*"},
+			RequiredSignatures: []string{"test"},
+			MinSignatures:      2,
+		})
+
+		data, err := json.Marshal(cfg)
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(Equal(`{"type":"verification.config.ocm.gardener.cloud","rules":[{"components":["github.com/mandelsoft/*"],"requiredSignatures":["test"],"minSignatures":2}]}`))
+
+		r := &verifyattr.Config{}
+		Expect(json.Unmarshal(data, r)).To(Succeed())
+		Expect(r).To(Equal(cfg))
+	})
+
+	It("applies", func() {
+		cfg := verifyattr.New()
+		cfg.AddRule(verifyattr.Rule{
+			Components:         []string{"github.com/mandelsoft/*"},
+			RequiredSignatures: []string{"test"},
+		})
+		Expect(cfgctx.ApplyConfig(cfg, "from test")).To(Succeed())
+
+		cfg = verifyattr.New()
+		cfg.AddRule(verifyattr.Rule{
+			VerifyReferences: []string{"github.com/acme/*"},
+		})
+		Expect(cfgctx.ApplyConfig(cfg, "from test")).To(Succeed())
+
+		policy := verifyattr.Get(cfgctx)
+		Expect(policy.Rules).To(HaveLen(2))
+		Expect(policy.RulesFor(COMPONENT)).To(HaveLen(2))
+		Expect(policy.RulesFor("github.com/acme/test")).To(HaveLen(1))
+		Expect(policy.VerifyReference(COMPONENT, "github.com/acme/ref")).To(BeTrue())
+		Expect(policy.VerifyReference(COMPONENT, "github.com/other/ref")).To(BeFalse())
+	})
+
+	It("checks trusted issuers", func() {
+		r := verifyattr.Rule{}
+		Expect(r.Trusted()).To(BeTrue())
+		Expect(r.GetMinSignatures()).To(Equal(1))
+
+		r.TrustedIssuers = []string{"acme*"}
+		Expect(r.Trusted("", "acme.org")).To(BeTrue())
+		Expect(r.Trusted("other")).To(BeFalse())
+	})
+})
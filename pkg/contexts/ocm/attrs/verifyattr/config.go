@@ -0,0 +1,99 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package verifyattr
+
+import (
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/config"
+	cfgcpi "github.com/open-component-model/ocm/pkg/contexts/config/cpi"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const (
+	ConfigType   = "verification.config" + common.TypeGroupSuffix
+	ConfigTypeV1 = ConfigType + runtime.VersionSeparator + "v1"
+)
+
+func init() {
+	cfgcpi.RegisterConfigType(ConfigType, cfgcpi.NewConfigType(ConfigType, &Config{}, usage))
+	cfgcpi.RegisterConfigType(ConfigTypeV1, cfgcpi.NewConfigType(ConfigTypeV1, &Config{}, usage))
+}
+
+// Config describes a verification policy.
+type Config struct {
+	runtime.ObjectVersionedType `json:",inline"`
+	Policy                      `json:",inline"`
+}
+
+// New creates a new verification policy config.
+func New() *Config {
+	return &Config{
+		ObjectVersionedType: runtime.NewVersionedObjectType(ConfigType),
+	}
+}
+
+func (a *Config) GetType() string {
+	return ConfigType
+}
+
+func (a *Config) AddRule(rule Rule) {
+	a.Rules = append(a.Rules, rule)
+}
+
+// ApplyTo adds the rules to the verification policy of the context.
+func (a *Config) ApplyTo(ctx cfgcpi.Context, target interface{}) error {
+	t, ok := target.(config.Context)
+	if !ok {
+		return cfgcpi.ErrNoContext(ConfigType)
+	}
+	policy := &Policy{}
+	policy.Add(Get(t))
+	policy.Add(&a.Policy)
+	return Set(t, policy)
+}
+
+const usage = `
+The config type <code>` + ConfigType + `</code> can be used to define
+a verification policy for component versions. It is enforced for all
+signature verifications. Multiple configurations are combined.
+
+A component version must fulfill all rules matching its component name.
+A rule is described by the following fields:
+- <code>components</code>: list of component name patterns the rule applies to
+  (all components, if not given)
+- <code>requiredSignatures</code>: list of signature names, which must be
+  present and verified
+- <code>trustedIssuers</code>: list of patterns for certificate subjects
+  (common name or full subject) accepted for verified signatures (all, if not
+  given). Only certificates verified against the configured root certificates
+  provide an issuer, the issuer claimed by a signature is not trusted
+- <code>minSignatures</code>: minimum number of verified signatures of trusted
+  issuers (default 1)
+- <code>verifyReferences</code>: list of component name patterns for references,
+  whose signatures must be verified recursively
+
+<pre>
+    type: ` + ConfigType + `
+    rules:
+    - components:
+      - github.com/acme/*
+      requiredSignatures:
+      - acme
+      trustedIssuers:
+      - acme.org
+      verifyReferences:
+      - github.com/acme/*
+</pre>
+`
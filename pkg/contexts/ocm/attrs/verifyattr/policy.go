@@ -0,0 +1,126 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package verifyattr
+
+import (
+	"path"
+	"reflect"
+)
+
+// Policy describes the requirements for the signatures of
+// component versions to be met by a verification.
+type Policy struct {
+	Rules []Rule `json:"rules,omitempty"`
+}
+
+// Rule describes the verification requirements for a set of components.
+type Rule struct {
+	// Components is a list of component name patterns the rule applies to.
+	// If no pattern is given, the rule applies to all components.
+	Components []string `json:"components,omitempty"`
+	// RequiredSignatures is a list of signature names, which must be
+	// present and verified for a component version.
+	RequiredSignatures []string `json:"requiredSignatures,omitempty"`
+	// TrustedIssuers is a list of patterns for certificate subjects
+	// accepted for verified signatures. Only certificates verified against
+	// the root certificates provide an issuer. If no pattern is given,
+	// all issuers are accepted.
+	TrustedIssuers []string `json:"trustedIssuers,omitempty"`
+	// MinSignatures is the minimum number of verified signatures
+	// with a trusted issuer. It defaults to one.
+	MinSignatures int `json:"minSignatures,omitempty"`
+	// VerifyReferences is a list of component name patterns for references,
+	// which must be verified recursively.
+	VerifyReferences []string `json:"verifyReferences,omitempty"`
+}
+
+// Match checks whether a rule applies to a component.
+func (r *Rule) Match(comp string) bool {
+	return len(r.Components) == 0 || MatchPattern(comp, r.Components...)
+}
+
+// Trusted checks whether one of the given issuer names is accepted
+// by the rule.
+func (r *Rule) Trusted(issuers ...string) bool {
+	if len(r.TrustedIssuers) == 0 {
+		return true
+	}
+	for _, i := range issuers {
+		if i != "" && MatchPattern(i, r.TrustedIssuers...) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMinSignatures returns the effective minimum number of signatures.
+func (r *Rule) GetMinSignatures() int {
+	if r.MinSignatures <= 0 {
+		return 1
+	}
+	return r.MinSignatures
+}
+
+// Add adds the rules of another policy, which are not yet
+// present.
+func (p *Policy) Add(o *Policy) {
+	if o == nil {
+		return
+	}
+outer:
+	for _, r := range o.Rules {
+		for _, e := range p.Rules {
+			if reflect.DeepEqual(r, e) {
+				continue outer
+			}
+		}
+		p.Rules = append(p.Rules, r)
+	}
+}
+
+// RulesFor returns the rules applicable for a component.
+func (p *Policy) RulesFor(comp string) []*Rule {
+	var result []*Rule
+	if p == nil {
+		return nil
+	}
+	for i := range p.Rules {
+		if p.Rules[i].Match(comp) {
+			result = append(result, &p.Rules[i])
+		}
+	}
+	return result
+}
+
+// VerifyReference checks whether a reference to component ref used by
+// component comp must be verified recursively.
+func (p *Policy) VerifyReference(comp, ref string) bool {
+	for _, r := range p.RulesFor(comp) {
+		if MatchPattern(ref, r.VerifyReferences...) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchPattern matches a name against a list of shell file name patterns.
+func MatchPattern(name string, patterns ...string) bool {
+	for _, p := range patterns {
+		if ok, err := path.Match(p, name); ok && err == nil {
+			return true
+		}
+	}
+	return false
+}
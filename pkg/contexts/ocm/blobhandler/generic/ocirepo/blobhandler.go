@@ -49,8 +49,7 @@ func (b *artefactHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi
 		return nil, nil
 	}
 
-	mediaType := blob.MimeType()
-	if !artdesc.IsOCIMediaType(mediaType) || (!strings.HasSuffix(mediaType, "+tar") && !strings.HasSuffix(mediaType, "+tar+gzip")) {
+	if !isArtefactBlob(blob.MimeType()) {
 		return nil, nil
 	}
 
@@ -63,17 +62,9 @@ func (b *artefactHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi
 	var name string
 	var tag string
 
-	if hint == "" {
-		name = path.Join(prefix, ctx.TargetComponentVersion().GetName())
-	} else {
-		i := strings.LastIndex(hint, ":")
-		if i > 0 {
-			version = hint[i:]
-			name = path.Join(prefix, hint[:i])
-			tag = version[1:] // remove colon
-		} else {
-			name = hint
-		}
+	name, version = target(hint, prefix, ctx.TargetComponentVersion().GetName())
+	if version != "" {
+		tag = version[1:] // remove colon
 	}
 	namespace, err = repo.LookupNamespace(name)
 	if err != nil {
@@ -104,3 +95,34 @@ func (b *artefactHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi
 	var acc cpi.AccessSpec = ociartefact.New(ref)
 	return acc, nil
 }
+
+func (b *artefactHandler) PlanBlob(mimeType string, hint string, ctx cpi.PlanningContext) (string, string, error) {
+	attr := ociuploadattr.Get(ctx.GetContext())
+	if attr == nil || !isArtefactBlob(mimeType) {
+		return "", "", nil
+	}
+
+	_, base, prefix, err := attr.GetInfo(ctx.GetContext())
+	if err != nil {
+		return "", "", err
+	}
+	name, version := target(hint, prefix, ctx.TargetComponentName())
+	return ociartefact.Type, base.ComposeRef(name + version), nil
+}
+
+// target determines the namespace and the tag (prefixed by a colon)
+// used to store an artefact blob of a component for the given hint.
+func target(hint, prefix, component string) (string, string) {
+	if hint == "" {
+		return path.Join(prefix, component), ""
+	}
+	i := strings.LastIndex(hint, ":")
+	if i > 0 {
+		return path.Join(prefix, hint[:i]), hint[i:]
+	}
+	return hint, ""
+}
+
+func isArtefactBlob(mediaType string) bool {
+	return artdesc.IsOCIMediaType(mediaType) && (strings.HasSuffix(mediaType, "+tar") || strings.HasSuffix(mediaType, "+tar+gzip"))
+}
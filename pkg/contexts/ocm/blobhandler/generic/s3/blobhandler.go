@@ -0,0 +1,86 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package s3
+
+import (
+	"path"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio/downloader/s3"
+	s3access "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/s3"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/s3uploadattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+func init() {
+	// must be preferred over the repository specific handlers storing local blobs.
+	cpi.RegisterBlobHandler(NewBlobHandler(), cpi.WithPrio(cpi.DEFAULT_BLOBHANDLER_PRIO+10))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// blobHandler uploads blobs with media types configured by the s3uploadattr
+// to an S3 bucket instead of storing them as local blobs.
+type blobHandler struct{}
+
+func NewBlobHandler() cpi.BlobHandler {
+	return &blobHandler{}
+}
+
+func (b *blobHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi.AccessSpec, ctx cpi.StorageContext) (cpi.AccessSpec, error) {
+	attr, prefix := target(ctx.GetContext(), blob.MimeType(), ctx.TargetComponentVersion().GetName())
+	if attr == nil {
+		return nil, nil
+	}
+
+	key := path.Join(prefix, common.DigestToFileName(blob.Digest()))
+	creds, err := s3access.GetCredentials(ctx.GetContext().CredentialsContext(), attr.Bucket, key, "")
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := blob.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	version, err := s3.NewUploader(attr.Region, attr.Bucket, key, s3access.AWSCreds(creds)).WithEndpoint(attr.Endpoint).Upload(r, blob.MimeType())
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot upload blob to bucket %s", attr.Bucket)
+	}
+	acc := s3access.New(attr.Region, attr.Bucket, key, version, blob.MimeType(), nil)
+	acc.Endpoint = attr.Endpoint
+	return acc, nil
+}
+
+func (b *blobHandler) PlanBlob(mimeType string, hint string, ctx cpi.PlanningContext) (string, string, error) {
+	attr, _ := target(ctx.GetContext(), mimeType, ctx.TargetComponentName())
+	if attr == nil {
+		return "", "", nil
+	}
+	return s3access.Type, "", nil
+}
+
+// target provides the upload configuration and the key prefix used
+// for blobs of a component, if blobs of the given media type should
+// be uploaded.
+func target(ctx cpi.Context, mimeType string, component string) (*s3uploadattr.Attribute, string) {
+	attr := s3uploadattr.Get(ctx)
+	if attr == nil || !attr.Matches(mimeType) {
+		return nil, ""
+	}
+	return attr, path.Join(attr.Prefix, component)
+}
@@ -0,0 +1,207 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package s3_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localblob"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/s3"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/s3uploadattr"
+	v1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/comparch"
+	ctfocm "github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+	tenv "github.com/open-component-model/ocm/pkg/env"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const COMP = "github.com/compa"
+const VERS = "1.0.0"
+const CA = "ca"
+const CTF = "ctf"
+
+const BUCKET = "blobs"
+const PREFIX = "ocm"
+const JAR = "this is a jar"
+
+// object is an object stored in the fake S3 store.
+type object struct {
+	data      []byte
+	mediaType string
+}
+
+// fakeS3 is a minimal in-process S3 compatible store
+// supporting path style PUT and GET requests.
+type fakeS3 struct {
+	lock    sync.Mutex
+	objects map[string]object
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.objects[key] = object{data, r.Header.Get("Content-Type")}
+		w.Header().Set("ETag", `"etag"`)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		o, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", o.mediaType)
+		http.ServeContent(w, r, key, time.Time{}, bytes.NewReader(o.data))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func Close(closer io.Closer) {
+	err := closer.Close()
+	ExpectWithOffset(1, err).To(Succeed())
+}
+
+var _ = Describe("upload", func() {
+	var env *Builder
+	var store *fakeS3
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		env = NewBuilder(tenv.NewEnvironment())
+		store = &fakeS3{objects: map[string]object{}}
+		server = httptest.NewServer(store)
+	})
+
+	AfterEach(func() {
+		server.Close()
+		env.Cleanup()
+	})
+
+	configure := func() {
+		attr := s3uploadattr.New(BUCKET, PREFIX, mime.MIME_JAR)
+		attr.Endpoint = server.URL
+		Expect(s3uploadattr.Set(env.OCMContext(), attr)).To(Succeed())
+	}
+
+	checkS3 := func(acc ocm.AccessSpec, expectedKey string) {
+		Expect(acc.GetKind()).To(Equal(s3.Type))
+		spec, err := env.OCMContext().AccessSpecForSpec(acc)
+		Expect(err).To(Succeed())
+		s3spec := spec.(*s3.AccessSpec)
+		Expect(s3spec.Bucket).To(Equal(BUCKET))
+		Expect(s3spec.Key).To(Equal(expectedKey))
+		Expect(s3spec.MediaType).To(Equal(mime.MIME_JAR))
+		Expect(s3spec.Endpoint).To(Equal(server.URL))
+		Expect(store.objects[BUCKET+"/"+expectedKey]).To(Equal(object{[]byte(JAR), mime.MIME_JAR}))
+	}
+
+	It("uploads matching local blobs when adding resources", func() {
+		key := PREFIX + "/" + COMP + "/" + common.DigestToFileName(accessio.BlobAccessForString(mime.MIME_JAR, JAR).Digest())
+
+		configure()
+		env.ComponentArchive(CA, accessio.FormatDirectory, COMP, VERS, func() {
+			env.Provider("mandelsoft")
+			env.Resource("jar", "", "jar", v1.LocalRelation, func() {
+				env.BlobStringData(mime.MIME_JAR, JAR)
+			})
+			env.Resource("text", "", "PlainText", v1.LocalRelation, func() {
+				env.BlobStringData(mime.MIME_TEXT, "text")
+			})
+		})
+
+		ca, err := comparch.Open(env.OCMContext(), accessobj.ACC_READONLY, CA, 0, env)
+		Expect(err).To(Succeed())
+		defer Close(ca)
+
+		r, err := ca.GetResourceByIndex(0)
+		Expect(err).To(Succeed())
+		acc, err := r.Access()
+		Expect(err).To(Succeed())
+		checkS3(acc, key)
+
+		m, err := r.AccessMethod()
+		Expect(err).To(Succeed())
+		defer Close(m)
+		data, err := m.Get()
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(Equal(JAR))
+
+		r, err = ca.GetResourceByIndex(1)
+		Expect(err).To(Succeed())
+		acc, err = r.Access()
+		Expect(err).To(Succeed())
+		Expect(acc.GetKind()).To(Equal(localblob.Type))
+	})
+
+	It("uploads matching local blobs during transfer", func() {
+		key := PREFIX + "/" + COMP + "/" + common.DigestToFileName(accessio.BlobAccessForString(mime.MIME_JAR, JAR).Digest())
+
+		env.ComponentArchive(CA, accessio.FormatDirectory, COMP, VERS, func() {
+			env.Provider("mandelsoft")
+			env.Resource("jar", "", "jar", v1.LocalRelation, func() {
+				env.BlobStringData(mime.MIME_JAR, JAR)
+			})
+		})
+		ca, err := comparch.Open(env.OCMContext(), accessobj.ACC_READONLY, CA, 0, env)
+		Expect(err).To(Succeed())
+		defer Close(ca)
+		r, err := ca.GetResourceByIndex(0)
+		Expect(err).To(Succeed())
+		acc, err := r.Access()
+		Expect(err).To(Succeed())
+		Expect(acc.GetKind()).To(Equal(localblob.Type))
+
+		configure()
+		ctf, err := ctfocm.Create(env.OCMContext(), accessobj.ACC_CREATE, CTF, 0o700, env)
+		Expect(err).To(Succeed())
+		defer Close(ctf)
+		handler, err := standard.New(standard.ResourcesByValue())
+		Expect(err).To(Succeed())
+		Expect(transfer.TransferVersion(nil, nil, ca, ctf, handler)).To(Succeed())
+
+		cv, err := ctf.LookupComponentVersion(COMP, VERS)
+		Expect(err).To(Succeed())
+		defer Close(cv)
+		r, err = cv.GetResourceByIndex(0)
+		Expect(err).To(Succeed())
+		acc, err = r.Access()
+		Expect(err).To(Succeed())
+		checkS3(acc, key)
+	})
+})
@@ -16,6 +16,7 @@ package blobhandler
 
 import (
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/generic/ocirepo"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/generic/s3"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/oci/ocirepo"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/ocm/comparch"
 )
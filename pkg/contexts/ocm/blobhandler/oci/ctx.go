@@ -46,6 +46,32 @@ func New(comprepo ocmcpi.Repository, vers ocmcpi.ComponentVersionAccess, impltyp
 	}
 }
 
+// PlanningContext is the context information passed to BlobPlanners
+// registered for context type oci.CONTEXT_TYPE.
+type PlanningContext struct {
+	ocmcpi.DefaultPlanningContext
+	Repository cpi.Repository
+	Namespace  string
+}
+
+var _ ocmcpi.PlanningContext = (*PlanningContext)(nil)
+
+func NewPlanningContext(comprepo ocmcpi.Repository, name string, impltyp string, ocirepo oci.Repository, namespace string) *PlanningContext {
+	return &PlanningContext{
+		DefaultPlanningContext: *ocmcpi.NewDefaultPlanningContext(comprepo, name, ocmcpi.ImplementationRepositoryType{ContextType: cpi.CONTEXT_TYPE, RepositoryType: impltyp}),
+		Repository:             ocirepo,
+		Namespace:              namespace,
+	}
+}
+
+// StorageContext provides a storage context for the repositories of the
+// planning context. It can be used to evaluate repository related
+// information, only. There is no component version, namespace or
+// manifest available.
+func (p *PlanningContext) StorageContext() *StorageContext {
+	return New(p.ComponentRepository, nil, p.ImplementationRepositoryType.RepositoryType, p.Repository, nil, nil)
+}
+
 func (s *StorageContext) TargetComponentRepository() ocmcpi.Repository {
 	return s.ComponentRepository
 }
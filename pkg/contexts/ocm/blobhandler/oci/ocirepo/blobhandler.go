@@ -24,6 +24,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ocireg"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/transfer"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localblob"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localociblob"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartefact"
@@ -51,6 +52,9 @@ func init() {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+// BaseFunction provides the base URL of the OCI repository described by a
+// storage context. During transfer planning, the storage context is taken
+// from the planning context and describes the repositories, only.
 type BaseFunction func(ctx *storagecontext.StorageContext) string
 
 func OCIRegBaseFunction(ctx *storagecontext.StorageContext) string {
@@ -85,7 +89,7 @@ func (b *blobHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi.Acc
 	if err != nil {
 		return nil, err
 	}
-	if compatattr.Get(ctx.GetContext()) {
+	if localAccessType(ctx.GetContext()) == localociblob.Type {
 		return localociblob.New(blob.Digest()), nil
 	} else {
 		if global == nil {
@@ -98,6 +102,19 @@ func (b *blobHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi.Acc
 	}
 }
 
+func (b *blobHandler) PlanBlob(mimeType string, hint string, ctx cpi.PlanningContext) (string, string, error) {
+	return localAccessType(ctx.GetContext()), "", nil
+}
+
+// localAccessType provides the type of the access specification
+// used for blobs stored along with the component version.
+func localAccessType(ctx cpi.Context) string {
+	if compatattr.Get(ctx) {
+		return localociblob.Type
+	}
+	return localblob.Type
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 // artefactHandler stores artefact blobs as OCIArtefacts.
@@ -110,9 +127,7 @@ func NewArtefactHandler(base BaseFunction) cpi.BlobHandler {
 }
 
 func (b *artefactHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi.AccessSpec, ctx cpi.StorageContext) (cpi.AccessSpec, error) {
-	mediaType := blob.MimeType()
-
-	if !artdesc.IsOCIMediaType(mediaType) || (!strings.HasSuffix(mediaType, "+tar") && !strings.HasSuffix(mediaType, "+tar+gzip")) {
+	if !isArtefactBlob(blob.MimeType()) {
 		return nil, nil
 	}
 
@@ -128,18 +143,13 @@ func (b *artefactHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi
 
 	ocictx := ctx.(*storagecontext.StorageContext)
 	base := b.GetBaseURL(ocictx)
-	if hint == "" {
+	name, version = artefactTarget(hint, ctx.TargetComponentRepository())
+	if version != "" {
+		tag = version[1:] // remove colon
+	}
+	if name == "" {
 		namespace = ocictx.Namespace
 	} else {
-		prefix := cpi.RepositoryPrefix(ctx.TargetComponentRepository().GetSpecification())
-		i := strings.LastIndex(hint, ":")
-		if i > 0 {
-			version = hint[i:]
-			tag = version[1:] // remove colon
-			name = path.Join(prefix, hint[:i])
-		} else {
-			name = path.Join(prefix, hint)
-		}
 		namespace, err = ocictx.Repository.LookupNamespace(name)
 		if err != nil {
 			return nil, err
@@ -163,7 +173,7 @@ func (b *artefactHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi
 		return nil, wrap(err, errhint, "get artefact from blob")
 	}
 
-	err = artefactset.TransferArtefact(art, namespace, oci.AsTags(tag)...)
+	err = transfer.TransferArtefactWithOptions(art, namespace, &transfer.Options{Referrers: set}, oci.AsTags(tag)...)
 	if err != nil {
 		return nil, wrap(err, errhint, "transfer artefact")
 	}
@@ -185,6 +195,42 @@ func (b *artefactHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi
 	return acc, nil
 }
 
+func (b *artefactHandler) PlanBlob(mimeType string, hint string, ctx cpi.PlanningContext) (string, string, error) {
+	if !isArtefactBlob(mimeType) {
+		return "", "", nil
+	}
+
+	ocictx := ctx.(*storagecontext.PlanningContext)
+	name, version := artefactTarget(hint, ctx.TargetComponentRepository())
+	if name == "" {
+		name = ocictx.Namespace
+	}
+	ref := path.Join(b.GetBaseURL(ocictx.StorageContext()), name) + version
+	if keepblobattr.Get(ctx.GetContext()) {
+		return localblob.Type, ref, nil
+	}
+	return ociartefact.Type, ref, nil
+}
+
+// artefactTarget determines the namespace and the tag (prefixed by a colon)
+// used to store an artefact blob for the given hint. An empty namespace
+// indicates the namespace of the component version.
+func artefactTarget(hint string, repo cpi.Repository) (string, string) {
+	if hint == "" {
+		return "", ""
+	}
+	prefix := cpi.RepositoryPrefix(repo.GetSpecification())
+	i := strings.LastIndex(hint, ":")
+	if i > 0 {
+		return path.Join(prefix, hint[:i]), hint[i:]
+	}
+	return path.Join(prefix, hint), ""
+}
+
+func isArtefactBlob(mediaType string) bool {
+	return artdesc.IsOCIMediaType(mediaType) && (strings.HasSuffix(mediaType, "+tar") || strings.HasSuffix(mediaType, "+tar+gzip"))
+}
+
 func wrap(err error, msg string, args ...interface{}) error {
 	for _, a := range args {
 		msg = fmt.Sprintf("%s: %s", msg, a)
@@ -49,9 +49,22 @@ func (b *blobHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi.Acc
 		return nil, err
 	}
 	path := common.DigestToFileName(blob.Digest())
-	if compatattr.Get(ctx.GetContext()) {
+	if accessType(ctx.GetContext()) == localfsblob.Type {
 		return localfsblob.New(path, blob.MimeType()), nil
 	} else {
 		return localblob.New(path, hint, blob.MimeType(), global), nil
 	}
 }
+
+func (b *blobHandler) PlanBlob(mimeType string, hint string, ctx cpi.PlanningContext) (string, string, error) {
+	return accessType(ctx.GetContext()), "", nil
+}
+
+// accessType provides the type of the access specification
+// used for blobs stored in the component archive.
+func accessType(ctx cpi.Context) string {
+	if compatattr.Get(ctx) {
+		return localfsblob.Type
+	}
+	return localblob.Type
+}
@@ -0,0 +1,198 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package compdesc
+
+import (
+	"encoding/json"
+	"sort"
+
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+)
+
+const (
+	DIFF_ADDED    = "added"
+	DIFF_REMOVED  = "removed"
+	DIFF_MODIFIED = "modified"
+)
+
+const (
+	KIND_COMPONENT = "component"
+	KIND_RESOURCE  = "resource"
+	KIND_SOURCE    = "source"
+	KIND_REFERENCE = "reference"
+)
+
+// DiffEntry describes a single difference between two component descriptors.
+// For added or removed elements the field is empty. For modifications
+// it describes the modified field and the old and new value of the field.
+// Complex values (like labels or access specifications) are
+// represented by their JSON serialization.
+type DiffEntry struct {
+	Kind     string          `json:"kind"`
+	Identity metav1.Identity `json:"identity,omitempty"`
+	Change   string          `json:"change"`
+	Field    string          `json:"field,omitempty"`
+	Old      string          `json:"old,omitempty"`
+	New      string          `json:"new,omitempty"`
+}
+
+// Diff is the list of differences between two component descriptors.
+type Diff []DiffEntry
+
+// IsEmpty returns whether there are no differences.
+func (d Diff) IsEmpty() bool {
+	return len(d) == 0
+}
+
+// ModifiedReferences returns the identities of the component references
+// existing in both descriptors, but with a changed version.
+func (d Diff) ModifiedReferences() []metav1.Identity {
+	var result []metav1.Identity
+	for _, e := range d {
+		if e.Kind == KIND_REFERENCE && e.Change == DIFF_MODIFIED && e.Field == "version" {
+			result = append(result, e.Identity)
+		}
+	}
+	return result
+}
+
+// Diff determines the differences between the actual component descriptor
+// (the old one) and the given other component descriptor (the new one).
+// Resources, sources and component references are matched by their identity.
+func (c *ComponentDescriptor) Diff(other *ComponentDescriptor) Diff {
+	var d differ
+
+	d.compare(KIND_COMPONENT, nil, "name", c.Name, other.Name)
+	d.compare(KIND_COMPONENT, nil, "version", c.Version, other.Version)
+	d.compare(KIND_COMPONENT, nil, "provider", string(c.Provider.Name), string(other.Provider.Name))
+	d.labels(KIND_COMPONENT, nil, "provider.labels", c.Provider.Labels, other.Provider.Labels)
+	d.labels(KIND_COMPONENT, nil, "labels", c.Labels, other.Labels)
+
+	d.elements(KIND_RESOURCE, c.Resources, other.Resources, func(o, n ElementMetaAccessor) {
+		or, nr := o.(*Resource), n.(*Resource)
+		id := or.GetIdentity(c.Resources)
+		d.compare(KIND_RESOURCE, id, "type", or.Type, nr.Type)
+		d.compare(KIND_RESOURCE, id, "relation", string(or.Relation), string(nr.Relation))
+		d.compare(KIND_RESOURCE, id, "digest", digestString(or.Digest), digestString(nr.Digest))
+		d.compare(KIND_RESOURCE, id, "access", asJSON(or.Access), asJSON(nr.Access))
+	})
+	d.elements(KIND_SOURCE, c.Sources, other.Sources, func(o, n ElementMetaAccessor) {
+		os, ns := o.(*Source), n.(*Source)
+		id := os.GetIdentity(c.Sources)
+		d.compare(KIND_SOURCE, id, "type", os.Type, ns.Type)
+		d.compare(KIND_SOURCE, id, "access", asJSON(os.Access), asJSON(ns.Access))
+	})
+	d.elements(KIND_REFERENCE, c.References, other.References, func(o, n ElementMetaAccessor) {
+		or, nr := o.(*ComponentReference), n.(*ComponentReference)
+		id := or.GetIdentity(c.References)
+		d.compare(KIND_REFERENCE, id, "componentName", or.ComponentName, nr.ComponentName)
+		d.compare(KIND_REFERENCE, id, "digest", digestString(or.Digest), digestString(nr.Digest))
+	})
+	return d.diff
+}
+
+type differ struct {
+	diff Diff
+}
+
+func (d *differ) add(e DiffEntry) {
+	d.diff = append(d.diff, e)
+}
+
+func (d *differ) compare(kind string, id metav1.Identity, field string, o, n string) {
+	if o != n {
+		d.add(DiffEntry{Kind: kind, Identity: id, Change: DIFF_MODIFIED, Field: field, Old: o, New: n})
+	}
+}
+
+func (d *differ) labels(kind string, id metav1.Identity, field string, o, n metav1.Labels) {
+	names := map[string]struct{}{}
+	for _, l := range o {
+		names[l.Name] = struct{}{}
+	}
+	for _, l := range n {
+		names[l.Name] = struct{}{}
+	}
+	list := make([]string, 0, len(names))
+	for name := range names {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	for _, name := range list {
+		d.compare(kind, id, field+"."+name, labelString(o, name), labelString(n, name))
+	}
+}
+
+// elements compares two element lists. Elements found in both lists
+// are passed to the given function to compare the element specific fields,
+// the common element meta data is compared here.
+func (d *differ) elements(kind string, o, n ElementAccessor, compare func(o, n ElementMetaAccessor)) {
+	found := map[int]bool{}
+	for i := 0; i < o.Len(); i++ {
+		oe := o.Get(i)
+		id := oe.GetMeta().GetIdentity(o)
+		j := findElement(n, id)
+		if j < 0 {
+			d.add(DiffEntry{Kind: kind, Identity: id, Change: DIFF_REMOVED})
+			continue
+		}
+		found[j] = true
+		ne := n.Get(j)
+		d.compare(kind, id, "version", oe.GetMeta().Version, ne.GetMeta().Version)
+		d.labels(kind, id, "labels", oe.GetMeta().Labels, ne.GetMeta().Labels)
+		compare(oe, ne)
+	}
+	for j := 0; j < n.Len(); j++ {
+		if !found[j] {
+			d.add(DiffEntry{Kind: kind, Identity: n.Get(j).GetMeta().GetIdentity(n), Change: DIFF_ADDED})
+		}
+	}
+}
+
+func findElement(list ElementAccessor, id metav1.Identity) int {
+	for i := 0; i < list.Len(); i++ {
+		if list.Get(i).GetMeta().GetIdentity(list).Equals(id) {
+			return i
+		}
+	}
+	return -1
+}
+
+func labelString(labels metav1.Labels, name string) string {
+	for _, l := range labels {
+		if l.Name == name {
+			return asJSON(l)
+		}
+	}
+	return ""
+}
+
+func digestString(d *metav1.DigestSpec) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
+
+func asJSON(o interface{}) string {
+	if o == nil {
+		return ""
+	}
+	data, err := json.Marshal(o)
+	if err != nil {
+		return err.Error()
+	}
+	return string(data)
+}
@@ -0,0 +1,171 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package compdesc_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+)
+
+var _ = Describe("diff", func() {
+	var CD1 = `
+meta:
+  schemaVersion: v2
+component:
+  name: acme.org/test
+  version: 1.0.0
+  provider: acme.org
+  repositoryContexts: []
+  labels:
+  - name: purpose
+    value: test
+  sources: []
+  componentReferences:
+  - name: ref
+    componentName: acme.org/ref
+    version: 1.0.0
+  - name: gone
+    componentName: acme.org/gone
+    version: 1.0.0
+  resources:
+  - name: image
+    type: ociImage
+    relation: external
+    version: 1.0.0
+    access:
+      type: ociArtefact
+      imageReference: ghcr.io/acme/image:1.0.0
+  - name: data
+    type: blob
+    relation: external
+    version: 1.0.0
+    access:
+      type: localBlob
+      localReference: sha256:1234
+      mediaType: text/plain
+`
+	var CD2 = `
+meta:
+  schemaVersion: v2
+component:
+  name: acme.org/test
+  version: 1.1.0
+  provider: acme.org
+  repositoryContexts: []
+  labels:
+  - name: purpose
+    value: production
+  sources: []
+  componentReferences:
+  - name: ref
+    componentName: acme.org/ref
+    version: 1.1.0
+  resources:
+  - name: image
+    type: ociImage
+    relation: external
+    version: 1.1.0
+    access:
+      type: ociArtefact
+      imageReference: ghcr.io/acme/image:1.1.0
+  - name: data
+    type: blob
+    relation: external
+    version: 1.0.0
+    access:
+      type: localBlob
+      localReference: sha256:1234
+      mediaType: text/plain
+  - name: chart
+    type: helmChart
+    relation: external
+    version: 1.1.0
+    access:
+      type: ociArtefact
+      imageReference: ghcr.io/acme/chart:1.1.0
+`
+
+	var cd1, cd2 *compdesc.ComponentDescriptor
+
+	BeforeEach(func() {
+		var err error
+		cd1, err = compdesc.Decode([]byte(CD1))
+		Expect(err).To(Succeed())
+		cd2, err = compdesc.Decode([]byte(CD2))
+		Expect(err).To(Succeed())
+	})
+
+	It("detects no differences for identical descriptors", func() {
+		Expect(cd1.Diff(cd1.Copy()).IsEmpty()).To(BeTrue())
+	})
+
+	It("detects differences", func() {
+		diff := cd1.Diff(cd2)
+		Expect(diff).To(ConsistOf(
+			compdesc.DiffEntry{
+				Kind:   compdesc.KIND_COMPONENT,
+				Change: compdesc.DIFF_MODIFIED,
+				Field:  "version",
+				Old:    "1.0.0",
+				New:    "1.1.0",
+			},
+			compdesc.DiffEntry{
+				Kind:   compdesc.KIND_COMPONENT,
+				Change: compdesc.DIFF_MODIFIED,
+				Field:  "labels.purpose",
+				Old:    `{"name":"purpose","value":"test"}`,
+				New:    `{"name":"purpose","value":"production"}`,
+			},
+			compdesc.DiffEntry{
+				Kind:     compdesc.KIND_RESOURCE,
+				Identity: metav1.NewIdentity("image"),
+				Change:   compdesc.DIFF_MODIFIED,
+				Field:    "version",
+				Old:      "1.0.0",
+				New:      "1.1.0",
+			},
+			compdesc.DiffEntry{
+				Kind:     compdesc.KIND_RESOURCE,
+				Identity: metav1.NewIdentity("image"),
+				Change:   compdesc.DIFF_MODIFIED,
+				Field:    "access",
+				Old:      `{"imageReference":"ghcr.io/acme/image:1.0.0","type":"ociArtefact"}`,
+				New:      `{"imageReference":"ghcr.io/acme/image:1.1.0","type":"ociArtefact"}`,
+			},
+			compdesc.DiffEntry{
+				Kind:     compdesc.KIND_RESOURCE,
+				Identity: metav1.NewIdentity("chart"),
+				Change:   compdesc.DIFF_ADDED,
+			},
+			compdesc.DiffEntry{
+				Kind:     compdesc.KIND_REFERENCE,
+				Identity: metav1.NewIdentity("ref"),
+				Change:   compdesc.DIFF_MODIFIED,
+				Field:    "version",
+				Old:      "1.0.0",
+				New:      "1.1.0",
+			},
+			compdesc.DiffEntry{
+				Kind:     compdesc.KIND_REFERENCE,
+				Identity: metav1.NewIdentity("gone"),
+				Change:   compdesc.DIFF_REMOVED,
+			},
+		))
+		Expect(diff.ModifiedReferences()).To(Equal([]metav1.Identity{metav1.NewIdentity("ref")}))
+	})
+})
@@ -17,4 +17,5 @@ package compdesc
 import (
 	_ "github.com/open-component-model/ocm/pkg/signing/handlers"
 	_ "github.com/open-component-model/ocm/pkg/signing/hasher"
+	_ "github.com/open-component-model/ocm/pkg/signing/keyproviders"
 )
@@ -16,6 +16,7 @@ package v1
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/open-component-model/ocm/pkg/signing"
 )
@@ -91,9 +92,10 @@ type SignatureSpec struct {
 // +k8s:deepcopy-gen=true
 // +k8s:openapi-gen=true
 type Signature struct {
-	Name      string        `json:"name"`
-	Digest    DigestSpec    `json:"digest"`
-	Signature SignatureSpec `json:"signature"`
+	Name      string         `json:"name"`
+	Digest    DigestSpec     `json:"digest"`
+	Signature SignatureSpec  `json:"signature"`
+	Timestamp *TimestampSpec `json:"timestamp,omitempty"`
 }
 
 // Copy provides a copy of the signature data.
@@ -102,6 +104,27 @@ func (s *Signature) Copy() *Signature {
 		return nil
 	}
 	r := *s
+	r.Timestamp = s.Timestamp.Copy()
+	return &r
+}
+
+// TimestampSpec describes an RFC 3161 timestamp token for the
+// signature value.
+// +k8s:deepcopy-gen=true
+// +k8s:openapi-gen=true
+type TimestampSpec struct {
+	// Value is the base64 encoded DER representation of the timestamp token.
+	Value string `json:"value"`
+	// Time is the time stated by the timestamp token (informational).
+	Time *time.Time `json:"time,omitempty"`
+}
+
+// Copy provides a copy of the timestamp spec.
+func (t *TimestampSpec) Copy() *TimestampSpec {
+	if t == nil {
+		return nil
+	}
+	r := *t
 	return &r
 }
 
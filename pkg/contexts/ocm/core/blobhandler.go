@@ -19,6 +19,8 @@ import (
 	"sort"
 	"strings"
 	"sync"
+
+	"github.com/open-component-model/ocm/pkg/errors"
 )
 
 type ImplementationRepositoryType struct {
@@ -61,10 +63,51 @@ type BlobHandler interface {
 	StoreBlob(blob BlobAccess, hint string, global AccessSpec, ctx StorageContext) (AccessSpec, error)
 }
 
+// PlanningContext is the context information passed to a BlobPlanner.
+// In contrast to a StorageContext it does not require a target component
+// version, it just describes the component version to be created.
+type PlanningContext interface {
+	GetContext() Context
+	TargetComponentRepository() Repository
+	TargetComponentName() string
+	GetImplementationRepositoryType() ImplementationRepositoryType
+}
+
+// PlanningContextProvider is an optional interface for repositories
+// supporting the planning of blob uploads for their component versions.
+type PlanningContextProvider interface {
+	GetPlanningContext(name string) (PlanningContext, error)
+}
+
+// BlobPlanner is an optional interface for a BlobHandler, which is able
+// to describe the decision of StoreBlob without storing anything.
+// It is used to plan transfers without executing them.
+type BlobPlanner interface {
+	// PlanBlob returns the kind of the access specification StoreBlob would
+	// return for a blob with the given media type and, if the blob would be
+	// uploaded as separate artefact, the expected reference.
+	// If StoreBlob would not handle the blob, an empty kind has to be returned
+	// without error.
+	PlanBlob(mimeType string, hint string, ctx PlanningContext) (string, string, error)
+}
+
+// PlanBlob plans the storage of a blob by the given handler.
+// It fails, if the handler does not support planning.
+func PlanBlob(h BlobHandler, mimeType string, hint string, ctx PlanningContext) (string, string, error) {
+	p, ok := h.(BlobPlanner)
+	if !ok {
+		return "", "", errors.ErrNotSupported("blob planning", fmt.Sprintf("%T", h))
+	}
+	return p.PlanBlob(mimeType, hint, ctx)
+}
+
 // MultiBlobHandler is a BlobHandler consisting of a sequence of handlers.
 type MultiBlobHandler []BlobHandler
 
-var _ sort.Interface = MultiBlobHandler(nil)
+var (
+	_ sort.Interface = MultiBlobHandler(nil)
+	_ BlobPlanner    = MultiBlobHandler(nil)
+)
 
 func (m MultiBlobHandler) StoreBlob(blob BlobAccess, hint string, global AccessSpec, ctx StorageContext) (AccessSpec, error) {
 	for _, h := range m {
@@ -79,6 +122,19 @@ func (m MultiBlobHandler) StoreBlob(blob BlobAccess, hint string, global AccessS
 	return nil, nil
 }
 
+func (m MultiBlobHandler) PlanBlob(mimeType string, hint string, ctx PlanningContext) (string, string, error) {
+	for _, h := range m {
+		kind, ref, err := PlanBlob(h, mimeType, hint, ctx)
+		if err != nil {
+			return "", "", err
+		}
+		if kind != "" {
+			return kind, ref, nil
+		}
+	}
+	return "", "", nil
+}
+
 func (m MultiBlobHandler) Len() int {
 	return len(m)
 }
@@ -193,6 +249,12 @@ type PrioBlobHandler struct {
 	Prio int
 }
 
+var _ BlobPlanner = (*PrioBlobHandler)(nil)
+
+func (p *PrioBlobHandler) PlanBlob(mimeType string, hint string, ctx PlanningContext) (string, string, error) {
+	return PlanBlob(p.BlobHandler, mimeType, hint, ctx)
+}
+
 type handlerCache struct {
 	cache map[BlobHandlerKey]BlobHandler
 }
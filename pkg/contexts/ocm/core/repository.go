@@ -54,6 +54,9 @@ type ComponentAccess interface {
 	LookupVersion(version string) (ComponentVersionAccess, error)
 	AddVersion(ComponentVersionAccess) error
 	NewVersion(version string, overrides ...bool) (ComponentVersionAccess, error)
+	// DeleteVersion removes a component version from the repository.
+	// Repository implementations may remove blobs not used anymore, also.
+	DeleteVersion(version string) error
 
 	Close() error
 }
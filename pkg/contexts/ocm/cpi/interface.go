@@ -58,6 +58,9 @@ type (
 	BlobHandlerOption            = core.BlobHandlerOption
 	StorageContext               = core.StorageContext
 	ImplementationRepositoryType = core.ImplementationRepositoryType
+	BlobPlanner                  = core.BlobPlanner
+	PlanningContext              = core.PlanningContext
+	PlanningContextProvider      = core.PlanningContextProvider
 )
 
 type (
@@ -83,6 +86,10 @@ func DefaultContext() core.Context {
 	return core.DefaultContext
 }
 
+// DEFAULT_BLOBHANDLER_PRIO is the priority used for blob handlers
+// registered without explicit priority.
+const DEFAULT_BLOBHANDLER_PRIO = core.DEFAULT_BLOBHANDLER_PRIO
+
 func WithPrio(p int) BlobHandlerOption {
 	return core.WithPrio(p)
 }
@@ -99,6 +106,12 @@ func RegisterRepositorySpecHandler(handler RepositorySpecHandler, types ...strin
 	core.RegisterRepositorySpecHandler(handler, types...)
 }
 
+// PlanBlob plans the storage of a blob by the given handler without
+// storing anything.
+func PlanBlob(h BlobHandler, mimeType string, hint string, ctx PlanningContext) (string, string, error) {
+	return core.PlanBlob(h, mimeType, hint, ctx)
+}
+
 func RegisterBlobHandler(handler BlobHandler, opts ...BlobHandlerOption) {
 	core.RegisterBlobHandler(handler, opts...)
 }
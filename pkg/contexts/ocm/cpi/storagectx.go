@@ -49,3 +49,37 @@ func (c *DefaultStorageContext) TargetComponentRepository() core.Repository {
 func (c *DefaultStorageContext) GetImplementationRepositoryType() ImplementationRepositoryType {
 	return c.ImplementationRepositoryType
 }
+
+////////////////////////////////////////////////////////////////////////////////
+
+type DefaultPlanningContext struct {
+	ComponentRepository          Repository
+	ComponentName                string
+	ImplementationRepositoryType ImplementationRepositoryType
+}
+
+var _ PlanningContext = (*DefaultPlanningContext)(nil)
+
+func NewDefaultPlanningContext(repo Repository, name string, reptype ImplementationRepositoryType) *DefaultPlanningContext {
+	return &DefaultPlanningContext{
+		ComponentRepository:          repo,
+		ComponentName:                name,
+		ImplementationRepositoryType: reptype,
+	}
+}
+
+func (c *DefaultPlanningContext) GetContext() core.Context {
+	return c.ComponentRepository.GetContext()
+}
+
+func (c *DefaultPlanningContext) TargetComponentRepository() core.Repository {
+	return c.ComponentRepository
+}
+
+func (c *DefaultPlanningContext) TargetComponentName() string {
+	return c.ComponentName
+}
+
+func (c *DefaultPlanningContext) GetImplementationRepositoryType() ImplementationRepositoryType {
+	return c.ImplementationRepositoryType
+}
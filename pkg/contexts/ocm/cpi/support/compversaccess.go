@@ -17,6 +17,7 @@ package support
 import (
 	"fmt"
 	"strconv"
+	"sync"
 
 	"github.com/open-component-model/ocm/pkg/common/accessio"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
@@ -55,6 +56,7 @@ func (s *ComponentVersionAccess) IsClosed() bool {
 ////////////////////////////////////////////////////////////////////////////////
 
 type componentVersionAccessImpl struct {
+	lock           sync.Mutex
 	refs           accessio.ReferencableCloser
 	lazy           bool
 	discardChanges bool
@@ -259,6 +261,9 @@ func (c *componentVersionAccessImpl) AdjustResourceAccess(meta *cpi.ResourceMeta
 		}
 	}
 
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	cd := c.GetDescriptor()
 	if idx := cd.GetResourceIndex(meta); idx == -1 {
 		return errors.ErrUnknown(cpi.KIND_RESOURCE, meta.GetIdentity(cd.Resources).String())
@@ -266,7 +271,7 @@ func (c *componentVersionAccessImpl) AdjustResourceAccess(meta *cpi.ResourceMeta
 		cd.Resources[idx].Access = acc
 	}
 
-	return c.Update(false)
+	return c.update(false)
 }
 
 func (c *componentVersionAccessImpl) checkAccessSpec(acc compdesc.AccessSpec) error {
@@ -302,6 +307,9 @@ func (c *componentVersionAccessImpl) SetResource(meta *cpi.ResourceMeta, acc com
 		}
 	}
 
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	cd := c.GetDescriptor()
 	if idx := cd.GetResourceIndex(meta); idx == -1 {
 		cd.Resources = append(c.GetDescriptor().Resources, *res)
@@ -312,7 +320,7 @@ func (c *componentVersionAccessImpl) SetResource(meta *cpi.ResourceMeta, acc com
 		}
 		cd.Resources[idx] = *res
 	}
-	return c.Update(false)
+	return c.update(false)
 }
 
 func (c *componentVersionAccessImpl) SetSource(meta *cpi.SourceMeta, acc compdesc.AccessSpec) error {
@@ -337,12 +345,15 @@ func (c *componentVersionAccessImpl) SetSource(meta *cpi.SourceMeta, acc compdes
 		res.Version = c.GetVersion()
 	}
 
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	if idx := c.GetDescriptor().GetSourceIndex(meta); idx == -1 {
 		c.GetDescriptor().Sources = append(c.GetDescriptor().Sources, *res)
 	} else {
 		c.GetDescriptor().Sources[idx] = *res
 	}
-	return c.Update(false)
+	return c.update(false)
 }
 
 // AddResource adds a blob resource to the current archive.
@@ -375,12 +386,15 @@ func (c *componentVersionAccessImpl) SetSourceBlob(meta *cpi.SourceMeta, blob cp
 ////////////////////////////////////////////////////////////////////////////////
 
 func (c *componentVersionAccessImpl) SetReference(ref *cpi.ComponentReference) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	if idx := c.GetDescriptor().GetComponentReferenceIndex(*ref); idx == -1 {
 		c.GetDescriptor().References = append(c.GetDescriptor().References, *ref)
 	} else {
 		c.GetDescriptor().References[idx] = *ref
 	}
-	return c.Update(false)
+	return c.update(false)
 }
 
 func (a *componentVersionAccessImpl) DiscardChanges() {
@@ -388,6 +402,12 @@ func (a *componentVersionAccessImpl) DiscardChanges() {
 }
 
 func (a *componentVersionAccessImpl) Update(final bool) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.update(final)
+}
+
+func (a *componentVersionAccessImpl) update(final bool) error {
 	if (final || !a.lazy) && !a.discardChanges {
 		return a.base.Update()
 	}
@@ -32,7 +32,10 @@ type Repository struct {
 	arch *ComponentArchive
 }
 
-var _ cpi.Repository = (*Repository)(nil)
+var (
+	_ cpi.Repository              = (*Repository)(nil)
+	_ cpi.PlanningContextProvider = (*Repository)(nil)
+)
 
 func NewRepository(ctx cpi.Context, s *RepositorySpec) (*Repository, error) {
 	if s.PathFileSystem == nil {
@@ -150,6 +153,12 @@ func (r *Repository) LookupComponent(name string) (cpi.ComponentAccess, error) {
 	return r.arch.comp, nil
 }
 
+// GetPlanningContext provides the context for planning blob uploads
+// for a component version of the given component.
+func (r *Repository) GetPlanningContext(name string) (cpi.PlanningContext, error) {
+	return cpi.NewDefaultPlanningContext(r, name, cpi.ImplementationRepositoryType{ContextType: cpi.CONTEXT_TYPE, RepositoryType: Type}), nil
+}
+
 func (r *Repository) Close() error {
 	r.lock.Lock()
 	defer r.lock.Unlock()
@@ -194,3 +203,9 @@ func (c *ComponentAccess) AddVersion(access cpi.ComponentVersionAccess) error {
 func (c *ComponentAccess) NewVersion(version string, overrides ...bool) (cpi.ComponentVersionAccess, error) {
 	return nil, errors.ErrNotSupported(errors.KIND_FUNCTION, "new version", Type)
 }
+
+// DeleteVersion is not supported, because a component archive
+// must always contain exactly one component version.
+func (c *ComponentAccess) DeleteVersion(version string) error {
+	return errors.ErrNotSupported(errors.KIND_FUNCTION, "delete version", Type)
+}
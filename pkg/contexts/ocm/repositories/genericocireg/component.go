@@ -170,3 +170,26 @@ func (c *componentAccessImpl) NewVersion(version string, overrides ...bool) (cpi
 	}
 	return newComponentVersionAccess(accessobj.ACC_CREATE, c, version, acc)
 }
+
+func (c *componentAccessImpl) DeleteVersion(version string) error {
+	v, err := c.View(false)
+	if err != nil {
+		return err
+	}
+	defer v.Close()
+
+	acc, err := c.namespace.GetArtefact(version)
+	if err != nil {
+		if errors.IsErrNotFound(err) {
+			return cpi.ErrComponentVersionNotFoundWrap(err, c.name, version)
+		}
+		return err
+	}
+	m := acc.ManifestAccess()
+	ok := m != nil && isComponentDescriptorConfig(m.GetDescriptor().Config.MediaType)
+	acc.Close()
+	if !ok {
+		return errors.ErrInvalid(cpi.KIND_COMPONENTVERSION, c.name+":"+version)
+	}
+	return c.namespace.DeleteArtefact(version)
+}
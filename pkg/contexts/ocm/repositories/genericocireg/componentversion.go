@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/opencontainers/go-digest"
 
@@ -63,6 +64,7 @@ func newComponentVersionAccess(mode accessobj.AccessMode, comp *componentAccessI
 ////////////////////////////////////////////////////////////////////////////////
 
 type ComponentVersionContainer struct {
+	lock     sync.Mutex
 	comp     *ComponentAccess
 	version  string
 	access   oci.ArtefactAccess
@@ -162,6 +164,9 @@ func (c *ComponentVersionContainer) AccessMethod(a cpi.AccessSpec) (cpi.AccessMe
 }
 
 func (c *ComponentVersionContainer) Update() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	err := c.Check()
 	if err != nil {
 		return fmt.Errorf("check failed: %w", err)
@@ -236,7 +241,9 @@ func (c *ComponentVersionContainer) AddBlobFor(storagectx cpi.StorageContext, bl
 	if err != nil {
 		return nil, err
 	}
+	c.lock.Lock()
 	err = storagectx.(*ocihdlr.StorageContext).AssureLayer(blob)
+	c.lock.Unlock()
 	if err != nil {
 		return nil, err
 	}
@@ -23,6 +23,7 @@ import (
 	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/mandelsoft/vfs/pkg/vfs"
 
+	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/common/accessio"
 	"github.com/open-component-model/ocm/pkg/common/accessobj"
 	"github.com/open-component-model/ocm/pkg/contexts/oci"
@@ -40,6 +41,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/genericocireg"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/genericocireg/componentmapping"
+	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/mime"
 )
 
@@ -106,6 +108,53 @@ var _ = Describe("component repository mapping", func() {
 		Expect(repo.Close()).To(Succeed())
 	})
 
+	It("deletes a component version", func() {
+		shared := accessio.BlobAccessForString(mime.MIME_OCTET, "shared")
+		data1 := accessio.BlobAccessForString(mime.MIME_OCTET, "v1data")
+		data2 := accessio.BlobAccessForString(mime.MIME_OCTET, "v2data")
+
+		repo, err := DefaultContext.RepositoryForSpec(spec)
+		Expect(err).To(Succeed())
+
+		comp, err := repo.LookupComponent(COMPONENT)
+		Expect(err).To(Succeed())
+
+		for v, blob := range map[string]accessio.BlobAccess{"v1": data1, "v2": data2} {
+			vers, err := comp.NewVersion(v)
+			Expect(err).To(Succeed())
+			_, err = vers.AddBlob(shared, "", nil)
+			Expect(err).To(Succeed())
+			_, err = vers.AddBlob(blob, "", nil)
+			Expect(err).To(Succeed())
+			Expect(comp.AddVersion(vers)).To(Succeed())
+			Expect(vers.Close()).To(Succeed())
+		}
+
+		Expect(comp.DeleteVersion("v1")).To(Succeed())
+		Expect(comp.ListVersions()).To(ConsistOf("v2"))
+		_, err = comp.LookupVersion("v1")
+		Expect(errors.IsErrNotFound(err)).To(BeTrue())
+		Expect(errors.IsErrNotFound(comp.DeleteVersion("v1"))).To(BeTrue())
+
+		Expect(comp.Close()).To(Succeed())
+		Expect(repo.Close()).To(Succeed())
+
+		blobs := "test/" + ctf.BlobsDirectoryName + "/"
+		Expect(vfs.FileExists(tempfs, blobs+common.DigestToFileName(data1.Digest()))).To(BeFalse())
+		Expect(vfs.FileExists(tempfs, blobs+common.DigestToFileName(data2.Digest()))).To(BeTrue())
+		Expect(vfs.FileExists(tempfs, blobs+common.DigestToFileName(shared.Digest()))).To(BeTrue())
+
+		// access it again
+		repo, err = DefaultContext.RepositoryForSpec(spec)
+		Expect(err).To(Succeed())
+		ok, err := repo.ExistsComponentVersion(COMPONENT, "v2")
+		Expect(err).To(Succeed())
+		Expect(ok).To(BeTrue())
+		_, err = repo.ExistsComponentVersion(COMPONENT, "v1")
+		Expect(err).To(HaveOccurred())
+		Expect(repo.Close()).To(Succeed())
+	})
+
 	It("imports blobs", func() {
 
 		base := func(ctx *storagecontext.StorageContext) string {
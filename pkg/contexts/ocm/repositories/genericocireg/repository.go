@@ -23,6 +23,7 @@ import (
 
 	"github.com/open-component-model/ocm/pkg/common/accessio"
 	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	ocihdlr "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/oci"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/genericocireg/componentmapping"
 	"github.com/open-component-model/ocm/pkg/errors"
@@ -41,6 +42,16 @@ func (r *Repository) Close() error {
 	return r.view.Close()
 }
 
+// GetPlanningContext provides the context for planning blob uploads
+// for a component version of the given component.
+func (r *Repository) GetPlanningContext(name string) (cpi.PlanningContext, error) {
+	namespace, err := r.MapComponentNameToNamespace(name)
+	if err != nil {
+		return nil, err
+	}
+	return ocihdlr.NewPlanningContext(r, name, r.ocirepo.GetSpecification().GetKind(), r.ocirepo, namespace), nil
+}
+
 type RepositoryImpl struct {
 	refs accessio.ReferencableCloser
 
@@ -49,7 +60,10 @@ type RepositoryImpl struct {
 	ocirepo oci.Repository
 }
 
-var _ cpi.Repository = (*Repository)(nil)
+var (
+	_ cpi.Repository              = (*Repository)(nil)
+	_ cpi.PlanningContextProvider = (*Repository)(nil)
+)
 
 func NewRepository(ctx cpi.Context, meta *ComponentRepositoryMeta, ocirepo oci.Repository) (cpi.Repository, error) {
 	repo := &RepositoryImpl{
@@ -147,11 +161,15 @@ func (r *RepositoryImpl) ExistsComponentVersion(name string, version string) (bo
 	if err != nil {
 		return false, err
 	}
-	switch desc.Config.MediaType {
+	return isComponentDescriptorConfig(desc.Config.MediaType), nil
+}
+
+func isComponentDescriptorConfig(mime string) bool {
+	switch mime {
 	case componentmapping.ComponentDescriptorConfigMimeType, componentmapping.ComponentDescriptorLegacyConfigMimeType:
-		return true, nil
+		return true
 	}
-	return false, nil
+	return false
 }
 
 func (r *RepositoryImpl) LookupComponent(name string) (cpi.ComponentAccess, error) {
@@ -21,6 +21,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/common/accessio"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verifyattr"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
 	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
@@ -56,6 +57,11 @@ func apply(printer common.Printer, state common.WalkingState, cv ocm.ComponentVe
 	octx := cv.GetContext()
 	printer.Printf("applying to version %q...\n", nv)
 
+	policy := opts.Policy
+	if policy == nil {
+		policy = verifyattr.Get(octx)
+	}
+
 	signatureNames := opts.SignatureNames
 	if len(signatureNames) == 0 {
 		for _, s := range cd.Signatures {
@@ -72,6 +78,7 @@ func apply(printer common.Printer, state common.WalkingState, cv ocm.ComponentVe
 				return nil, errors.Newf("signature %q not found in %s", n, state.History)
 			}
 		}
+		signatureNames = requiredSignatures(policy, cd.GetName(), signatureNames)
 	}
 
 	for i, reference := range cd.References {
@@ -79,7 +86,9 @@ func apply(printer common.Printer, state common.WalkingState, cv ocm.ComponentVe
 		if reference.Digest == nil && !opts.DoUpdate() {
 			printer.Printf("  no digest given for reference %s", reference)
 		}
-		if reference.Digest == nil || opts.Recursively || opts.Verify {
+		// the verification policy may require to verify the signatures of referenced component versions
+		force := opts.DoVerify() && !opts.DoSign() && policy.VerifyReference(cd.GetName(), reference.GetComponentName())
+		if reference.Digest == nil || opts.Recursively || opts.Verify || force {
 			nested, err := opts.Resolver.LookupComponentVersion(reference.GetComponentName(), reference.GetVersion())
 			if err != nil {
 				return nil, errors.Wrapf(err, refMsg(reference, state, "failed resolving component reference"))
@@ -90,6 +99,10 @@ func apply(printer common.Printer, state common.WalkingState, cv ocm.ComponentVe
 			if err != nil {
 				return nil, errors.Wrapf(err, refMsg(reference, state, "failed resolving hasher for existing digest for component reference"))
 			}
+			if force {
+				digestOpts.VerifySignature = true
+				digestOpts.SignatureNames = nil
+			}
 			calculatedDigest, err = apply(printer.AddGap("  "), state, nested, digestOpts)
 			if err != nil {
 				return nil, errors.Wrapf(err, refMsg(reference, state, "failed applying to component reference"))
@@ -164,7 +177,7 @@ func apply(printer common.Printer, state common.WalkingState, cv ocm.ComponentVe
 	}
 
 	if opts.DoVerify() {
-		found := []string{}
+		found := []verifiedSignature{}
 		for _, n := range signatureNames {
 			f := cd.GetSignatureIndex(n)
 			if f < 0 {
@@ -195,13 +208,27 @@ func apply(printer common.Printer, state common.WalkingState, cv ocm.ComponentVe
 			if err != nil {
 				return nil, errors.ErrInvalidWrap(err, compdesc.KIND_SIGNATURE, sig.Signature.Algorithm, state.History.String())
 			}
-			found = append(found, n)
+			at, err := verifyTimestamp(sig, opts.TSARootCerts)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid timestamp for signature %q in %s", n, state.History)
+			}
+			err = verifyCertificateTime(pub, at, opts.RootCerts, n, state)
+			if err != nil {
+				return nil, err
+			}
+			found = append(found, newVerifiedSignature(n, pub, opts.RootCerts, at))
 		}
 		if len(found) == 0 {
 			if !opts.DoSign() {
 				return nil, errors.Newf("no verifiable signature found in %s", state.History)
 			}
 		}
+		if !opts.DoSign() {
+			err = checkPolicy(policy, cd.GetName(), found, state)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	found := cd.GetSignatureIndex(opts.SignatureName())
@@ -227,6 +254,12 @@ func apply(printer common.Printer, state common.WalkingState, cv ocm.ComponentVe
 				Issuer:    sig.Issuer,
 			},
 		}
+		if opts.TSAUrl != "" {
+			err = requestTimestamp(opts.TSAUrl, &signature)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed timestamping signature for %s", state.History)
+			}
+		}
 		if found >= 0 {
 			cd.Signatures[found] = signature
 		} else {
@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verifyattr"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
 	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/errors"
@@ -207,6 +208,23 @@ func (o *rootverts) ApplySigningOption(opts *Options) {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+type tsarootcerts struct {
+	pool *x509.CertPool
+}
+
+// TSARootCertificates sets the root certificates of trusted timestamp
+// authorities used to verify timestamp tokens of signatures.
+// If not set, the system root certificates are used.
+func TSARootCertificates(pool *x509.CertPool) Option {
+	return &tsarootcerts{pool}
+}
+
+func (o *tsarootcerts) ApplySigningOption(opts *Options) {
+	opts.TSARootCerts = o.pool
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 type privkey struct {
 	name string
 	key  interface{}
@@ -243,6 +261,39 @@ func (o *pubkey) ApplySigningOption(opts *Options) {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+type tsaurl struct {
+	url string
+}
+
+// TSA sets the URL of an RFC 3161 timestamp authority used to
+// request a timestamp token for created signatures.
+func TSA(url string) Option {
+	return &tsaurl{url}
+}
+
+func (o *tsaurl) ApplySigningOption(opts *Options) {
+	opts.TSAUrl = o.url
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type policy struct {
+	policy *verifyattr.Policy
+}
+
+// VerificationPolicy sets the verification policy to enforce for
+// signature verification. If not set, the policy configured for
+// the OCM context is used.
+func VerificationPolicy(p *verifyattr.Policy) Option {
+	return &policy{p}
+}
+
+func (o *policy) ApplySigningOption(opts *Options) {
+	opts.Policy = o.policy
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 type Options struct {
 	Update            bool
 	Recursively       bool
@@ -251,6 +302,8 @@ type Options struct {
 	Issuer            string
 	VerifySignature   bool
 	RootCerts         *x509.CertPool
+	TSAUrl            string
+	TSARootCerts      *x509.CertPool
 	Hasher            signing.Hasher
 	Keys              signing.KeyRegistry
 	Registry          signing.Registry
@@ -258,6 +311,7 @@ type Options struct {
 	SkipAccessTypes   map[string]bool
 	SignatureNames    []string
 	NormalizationAlgo string
+	Policy            *verifyattr.Policy
 }
 
 var _ Option = (*Options)(nil)
@@ -303,12 +357,21 @@ func (o *Options) ApplySigningOption(opts *Options) {
 	if o.Issuer != "" {
 		opts.Issuer = o.Issuer
 	}
+	if o.TSAUrl != "" {
+		opts.TSAUrl = o.TSAUrl
+	}
+	if o.TSARootCerts != nil {
+		opts.TSARootCerts = o.TSARootCerts
+	}
 	opts.Recursively = o.Recursively
 	opts.Update = o.Update
 	opts.Verify = o.Verify
 	if o.NormalizationAlgo != "" {
 		opts.NormalizationAlgo = o.NormalizationAlgo
 	}
+	if o.Policy != nil {
+		opts.Policy = o.Policy
+	}
 }
 
 func (o *Options) Complete(registry signing.Registry) error {
@@ -370,7 +433,9 @@ func (o *Options) checkCert(data interface{}, name string) error {
 		return nil
 	}
 	err = signing.VerifyCert(nil, o.RootCerts, "", cert)
-	if err != nil {
+	// expired certificates may still be valid for the time of the signature
+	// given by a timestamp, this is checked during the verification.
+	if err != nil && !signing.IsExpired(err) {
 		return errors.Wrapf(err, "public key %q", name)
 	}
 	return nil
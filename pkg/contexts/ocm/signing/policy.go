@@ -0,0 +1,92 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package signing
+
+import (
+	"crypto/x509"
+	"time"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verifyattr"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+)
+
+// verifiedSignature describes a successfully verified signature
+// and the identities it is issued by.
+type verifiedSignature struct {
+	name    string
+	issuers []string
+}
+
+// newVerifiedSignature describes a verified signature. The issuer claimed
+// by the signature itself is not authenticated, therefore issuers are only
+// taken from a certificate used as public key, which could be verified
+// against the configured root certificates (at signing time given by at,
+// for expired certificates). A plain public key has no verified issuer.
+func newVerifiedSignature(name string, pub interface{}, roots *x509.CertPool, at *time.Time) verifiedSignature {
+	v := verifiedSignature{name: name}
+	cert, err := signing.GetCertificate(pub)
+	if err != nil || roots == nil {
+		return v
+	}
+	t := time.Now()
+	if at != nil && t.After(cert.NotAfter) {
+		t = *at
+	}
+	if signing.VerifyCertAt(nil, roots, "", cert, t) == nil {
+		v.issuers = append(v.issuers, cert.Subject.CommonName, cert.Subject.String())
+	}
+	return v
+}
+
+// requiredSignatures returns the signature names additionally required
+// by the policy for a component.
+func requiredSignatures(policy *verifyattr.Policy, comp string, names []string) []string {
+	for _, r := range policy.RulesFor(comp) {
+	outer:
+		for _, n := range r.RequiredSignatures {
+			for _, e := range names {
+				if e == n {
+					continue outer
+				}
+			}
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+// checkPolicy checks the verified signatures of a component version against
+// the rules of a verification policy.
+func checkPolicy(policy *verifyattr.Policy, comp string, verified []verifiedSignature, state common.WalkingState) error {
+	for i, r := range policy.RulesFor(comp) {
+		trusted := map[string]bool{}
+		for _, v := range verified {
+			if r.Trusted(v.issuers...) {
+				trusted[v.name] = true
+			}
+		}
+		for _, n := range r.RequiredSignatures {
+			if !trusted[n] {
+				return errors.Newf("verification policy rule %d: required signature %q not verified by trusted issuer in %s", i+1, n, state.History)
+			}
+		}
+		if len(trusted) < r.GetMinSignatures() {
+			return errors.Newf("verification policy rule %d: %d signature(s) of trusted issuers required, but %d found in %s", i+1, r.GetMinSignatures(), len(trusted), state.History)
+		}
+	}
+	return nil
+}
@@ -15,6 +15,10 @@
 package signing_test
 
 import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
@@ -29,6 +33,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartefact"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verifyattr"
 	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
@@ -273,6 +278,149 @@ var _ = Describe("access method", func() {
 			Expect(dig.Value).To(Equal(digest))
 		})
 
+		It("enforces verification policy", func() {
+			session := datacontext.NewSession()
+			defer session.Close()
+
+			capriv, capub, err := rsa.Handler{}.CreateKeyPair()
+			Expect(err).To(Succeed())
+			caData, err := signing.CreateCertificate(pkix.Name{CommonName: "ca-authority"}, nil, 10*time.Hour, capub, nil, capriv, true)
+			Expect(err).To(Succeed())
+			ca, err := x509.ParseCertificate(caData)
+			Expect(err).To(Succeed())
+			pool := x509.NewCertPool()
+			pool.AddCert(ca)
+
+			priv, pub, err := rsa.Handler{}.CreateKeyPair()
+			Expect(err).To(Succeed())
+			certData, err := signing.CreateCertificate(pkix.Name{CommonName: PROVIDER}, nil, 10*time.Hour, pub, ca, capriv, false)
+			Expect(err).To(Succeed())
+			cert, err := x509.ParseCertificate(certData)
+			Expect(err).To(Succeed())
+
+			src, err := ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, ARCH, 0, env)
+			Expect(err).To(Succeed())
+			archcloser := session.AddCloser(src)
+			resolver := ocm.NewCompoundResolver(src)
+
+			cv, err := resolver.LookupComponentVersion(COMPONENTB, VERSION)
+			Expect(err).To(Succeed())
+			closer := session.AddCloser(cv)
+
+			opts := NewOptions(
+				Sign(signing.DefaultHandlerRegistry().GetSigner(SIGN_ALGO), SIGNATURE),
+				PrivateKey(SIGNATURE, priv),
+				PublicKey(SIGNATURE, cert),
+				RootCertificates(pool),
+				Resolver(resolver),
+				Update(), VerifyDigests(),
+			)
+			Expect(opts.Complete(signingattr.Get(DefaultContext))).To(Succeed())
+			_, err = Apply(nil, nil, cv, opts)
+			Expect(err).To(Succeed())
+			closer.Close()
+			archcloser.Close()
+
+			src, err = ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+			Expect(err).To(Succeed())
+			session.AddCloser(src)
+			cv, err = src.LookupComponentVersion(COMPONENTB, VERSION)
+			Expect(err).To(Succeed())
+			session.AddCloser(cv)
+
+			verify := func(rule verifyattr.Rule) error {
+				opts := NewOptions(
+					VerifySignature(),
+					PublicKey(SIGNATURE, cert),
+					RootCertificates(pool),
+					Resolver(src),
+					VerifyDigests(),
+					VerificationPolicy(&verifyattr.Policy{Rules: []verifyattr.Rule{rule}}),
+				)
+				Expect(opts.Complete(signingattr.Get(DefaultContext))).To(Succeed())
+				_, err := Apply(nil, nil, cv, opts)
+				return err
+			}
+
+			Expect(verify(verifyattr.Rule{
+				RequiredSignatures: []string{SIGNATURE},
+				TrustedIssuers:     []string{"mandel*"},
+			})).To(Succeed())
+			Expect(verify(verifyattr.Rule{
+				Components:         []string{"github.com/other/*"},
+				RequiredSignatures: []string{"other"},
+			})).To(Succeed())
+
+			err = verify(verifyattr.Rule{
+				TrustedIssuers: []string{"acme"},
+			})
+			Expect(err).To(MatchError("verification policy rule 1: 1 signature(s) of trusted issuers required, but 0 found in github.com/mandelsoft/ref:v1"))
+
+			err = verify(verifyattr.Rule{
+				RequiredSignatures: []string{"other"},
+			})
+			Expect(err).To(MatchError("verification policy rule 1: required signature \"other\" not verified by trusted issuer in github.com/mandelsoft/ref:v1"))
+
+			err = verify(verifyattr.Rule{
+				MinSignatures: 2,
+			})
+			Expect(err).To(MatchError("verification policy rule 1: 2 signature(s) of trusted issuers required, but 1 found in github.com/mandelsoft/ref:v1"))
+
+			err = verify(verifyattr.Rule{
+				VerifyReferences: []string{"github.com/mandelsoft/*"},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no signature found in github.com/mandelsoft/ref:v1->github.com/mandelsoft/test:v1"))
+		})
+
+		It("does not trust the unverified issuer of a signature", func() {
+			session := datacontext.NewSession()
+			defer session.Close()
+
+			src, err := ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, ARCH, 0, env)
+			Expect(err).To(Succeed())
+			archcloser := session.AddCloser(src)
+			resolver := ocm.NewCompoundResolver(src)
+
+			cv, err := resolver.LookupComponentVersion(COMPONENTB, VERSION)
+			Expect(err).To(Succeed())
+			closer := session.AddCloser(cv)
+
+			// plain key with a forged issuer claim
+			opts := NewOptions(
+				Sign(signing.DefaultHandlerRegistry().GetSigner(SIGN_ALGO), SIGNATURE),
+				Issuer(PROVIDER),
+				Resolver(resolver),
+				Update(), VerifyDigests(),
+			)
+			Expect(opts.Complete(signingattr.Get(DefaultContext))).To(Succeed())
+			_, err = Apply(nil, nil, cv, opts)
+			Expect(err).To(Succeed())
+			closer.Close()
+			archcloser.Close()
+
+			src, err = ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+			Expect(err).To(Succeed())
+			session.AddCloser(src)
+			cv, err = src.LookupComponentVersion(COMPONENTB, VERSION)
+			Expect(err).To(Succeed())
+			session.AddCloser(cv)
+			Expect(cv.GetDescriptor().Signatures[0].Signature.Issuer).To(Equal(PROVIDER))
+
+			opts = NewOptions(
+				VerifySignature(),
+				Resolver(src),
+				VerifyDigests(),
+				VerificationPolicy(&verifyattr.Policy{Rules: []verifyattr.Rule{{
+					RequiredSignatures: []string{SIGNATURE},
+					TrustedIssuers:     []string{"mandel*"},
+				}}}),
+			)
+			Expect(opts.Complete(signingattr.Get(DefaultContext))).To(Succeed())
+			_, err = Apply(nil, nil, cv, opts)
+			Expect(err).To(MatchError("verification policy rule 1: required signature \"test\" not verified by trusted issuer in github.com/mandelsoft/ref:v1"))
+		})
+
 		It("fails generic verification", func() {
 			session := datacontext.NewSession()
 			defer session.Close()
@@ -0,0 +1,77 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package signing
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"time"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/tsa"
+)
+
+// requestTimestamp requests a timestamp token for the signature value
+// from a timestamp authority.
+func requestTimestamp(url string, sig *metav1.Signature) error {
+	ts, err := tsa.Request(url, []byte(sig.Signature.Value))
+	if err != nil {
+		return err
+	}
+	t := ts.Time.UTC()
+	sig.Timestamp = &metav1.TimestampSpec{
+		Value: base64.StdEncoding.EncodeToString(ts.RawToken),
+		Time:  &t,
+	}
+	return nil
+}
+
+// verifyTimestamp verifies the timestamp token of a signature, if present,
+// against the root certificates of trusted timestamp authorities
+// and provides the trusted time of the signature.
+func verifyTimestamp(sig *metav1.Signature, roots *x509.CertPool) (*time.Time, error) {
+	if sig.Timestamp == nil {
+		return nil, nil
+	}
+	token, err := base64.StdEncoding.DecodeString(sig.Timestamp.Value)
+	if err != nil {
+		return nil, errors.ErrInvalidWrap(err, tsa.KIND_TIMESTAMP)
+	}
+	ts, err := tsa.Verify(token, []byte(sig.Signature.Value), roots)
+	if err != nil {
+		return nil, err
+	}
+	return &ts.Time, nil
+}
+
+// verifyCertificateTime checks the validity of an expired certificate used
+// as public key for the time of the signature given by its timestamp.
+func verifyCertificateTime(pub interface{}, at *time.Time, roots *x509.CertPool, name string, state common.WalkingState) error {
+	cert, err := signing.GetCertificate(pub)
+	if err != nil || !time.Now().After(cert.NotAfter) {
+		return nil
+	}
+	if at == nil {
+		return errors.Newf("certificate for signature %q expired and no timestamp found in %s", name, state.History)
+	}
+	err = signing.VerifyCertAt(nil, roots, "", cert, *at)
+	if err != nil {
+		return errors.Wrapf(err, "certificate for signature %q not valid at signing time %s in %s", name, at.Format(time.RFC3339), state.History)
+	}
+	return nil
+}
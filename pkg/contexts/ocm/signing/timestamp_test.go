@@ -0,0 +1,213 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package signing_test
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	tenv "github.com/open-component-model/ocm/pkg/env"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+	"github.com/open-component-model/ocm/pkg/signing/tsa"
+)
+
+var _ = Describe("timestamps", func() {
+	var env *Builder
+	var server *httptest.Server
+	var tsaTime time.Time
+
+	now := time.Now()
+	validFrom := now.Add(-10 * time.Hour)
+
+	capriv, capub, err := rsa.Handler{}.CreateKeyPair()
+	Expect(err).To(Succeed())
+	caData, err := signing.CreateCertificate(pkix.Name{CommonName: "ca-authority"}, &validFrom, 20*time.Hour, capub, nil, capriv, true)
+	Expect(err).To(Succeed())
+	ca, err := x509.ParseCertificate(caData)
+	Expect(err).To(Succeed())
+
+	// the timestamp authority uses its own trust root.
+	tsacapriv, tsacapub, err := rsa.Handler{}.CreateKeyPair()
+	Expect(err).To(Succeed())
+	tsacaData, err := signing.CreateTimestampingCertificate(pkix.Name{CommonName: "tsa-authority"}, &validFrom, 20*time.Hour, tsacapub, nil, tsacapriv, true)
+	Expect(err).To(Succeed())
+	tsaca, err := x509.ParseCertificate(tsacaData)
+	Expect(err).To(Succeed())
+
+	tsapriv, tsapub, err := rsa.Handler{}.CreateKeyPair()
+	Expect(err).To(Succeed())
+	tsaData, err := signing.CreateTimestampingCertificate(pkix.Name{CommonName: "tsa"}, &validFrom, 20*time.Hour, tsapub, tsaca, tsacapriv, false)
+	Expect(err).To(Succeed())
+	tsaCert, err := x509.ParseCertificate(tsaData)
+	Expect(err).To(Succeed())
+
+	// the signing certificate expired five hours ago.
+	priv, pub, err := rsa.Handler{}.CreateKeyPair()
+	Expect(err).To(Succeed())
+	certData, err := signing.CreateCertificate(pkix.Name{CommonName: "mandelsoft"}, &validFrom, 5*time.Hour, pub, ca, capriv, false)
+	Expect(err).To(Succeed())
+	cert, err := x509.ParseCertificate(certData)
+	Expect(err).To(Succeed())
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+
+	tsapool := x509.NewCertPool()
+	tsapool.AddCert(tsaca)
+
+	BeforeEach(func() {
+		env = NewBuilder(tenv.NewEnvironment())
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Component(COMPONENTA, func() {
+				env.Version(VERSION, func() {
+					env.Provider(PROVIDER)
+					env.Resource("testdata", "", "PlainText", metav1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata")
+					})
+				})
+			})
+		})
+		server = httptest.NewServer(&tsa.Server{
+			Certificate: tsaCert,
+			Signer:      tsapriv.(crypto.Signer),
+			Now:         func() time.Time { return tsaTime },
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+		env.Cleanup()
+	})
+
+	sign := func(opts ...Option) {
+		session := datacontext.NewSession()
+		defer session.Close()
+
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		session.AddCloser(src)
+		cv, err := src.LookupComponentVersion(COMPONENTA, VERSION)
+		Expect(err).To(Succeed())
+		session.AddCloser(cv)
+
+		o := NewOptions(
+			Sign(signing.DefaultHandlerRegistry().GetSigner(SIGN_ALGO), SIGNATURE),
+			PrivateKey(SIGNATURE, priv),
+			Resolver(src),
+			Update(), VerifyDigests(),
+		).Eval(opts...)
+		Expect(o.Complete(signing.DefaultRegistry())).To(Succeed())
+		_, err = Apply(nil, nil, cv, o)
+		Expect(err).To(Succeed())
+	}
+
+	verify := func(opts ...Option) (*metav1.Signature, error) {
+		session := datacontext.NewSession()
+		defer session.Close()
+
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		session.AddCloser(src)
+		cv, err := src.LookupComponentVersion(COMPONENTA, VERSION)
+		Expect(err).To(Succeed())
+		session.AddCloser(cv)
+
+		o := NewOptions(
+			VerifySignature(SIGNATURE),
+			PublicKey(SIGNATURE, cert),
+			RootCertificates(pool),
+			TSARootCertificates(tsapool),
+			Resolver(ocm.NewCompoundResolver(src)),
+			VerifyDigests(),
+		).Eval(opts...)
+		Expect(o.Complete(signing.DefaultRegistry())).To(Succeed())
+		_, err = Apply(nil, nil, cv, o)
+		return cv.GetDescriptor().SelectSignatureByName(SIGNATURE), err
+	}
+
+	It("verifies expired certificate with timestamp", func() {
+		tsaTime = now.Add(-7 * time.Hour).UTC().Truncate(time.Second)
+		sign(TSA(server.URL))
+
+		sig, err := verify()
+		Expect(err).To(Succeed())
+		Expect(sig.Timestamp).NotTo(BeNil())
+		Expect(*sig.Timestamp.Time).To(Equal(tsaTime))
+	})
+
+	It("rejects expired certificate without timestamp", func() {
+		sign()
+
+		_, err := verify()
+		Expect(err).To(MatchError(`certificate for signature "test" expired and no timestamp found in github.com/mandelsoft/test:v1`))
+	})
+
+	It("rejects expired certificate with later timestamp", func() {
+		tsaTime = now.Add(-time.Hour).UTC().Truncate(time.Second)
+		sign(TSA(server.URL))
+
+		_, err := verify()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`certificate for signature "test" not valid at signing time`))
+	})
+
+	It("rejects timestamp of authority trusted for code signing only", func() {
+		tsaTime = now.Add(-7 * time.Hour).UTC().Truncate(time.Second)
+		sign(TSA(server.URL))
+
+		_, err := verify(TSARootCertificates(pool))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`invalid timestamp for signature "test"`))
+		Expect(err.Error()).To(ContainSubstring("cannot verify timestamp authority"))
+	})
+
+	It("rejects backdated timestamp signed by a code signing certificate", func() {
+		// any other key with a code signing certificate under the same
+		// root mints a backdated timestamp to revive the expired certificate.
+		otherpriv, otherpub, err := rsa.Handler{}.CreateKeyPair()
+		Expect(err).To(Succeed())
+		otherData, err := signing.CreateCertificate(pkix.Name{CommonName: "other"}, &validFrom, 20*time.Hour, otherpub, ca, capriv, false)
+		Expect(err).To(Succeed())
+		other, err := x509.ParseCertificate(otherData)
+		Expect(err).To(Succeed())
+
+		forged := httptest.NewServer(&tsa.Server{
+			Certificate: other,
+			Signer:      otherpriv.(crypto.Signer),
+			Now:         func() time.Time { return now.Add(-7 * time.Hour).UTC().Truncate(time.Second) },
+		})
+		defer forged.Close()
+		sign(TSA(forged.URL))
+
+		_, err = verify(TSARootCertificates(pool))
+		Expect(err).To(MatchError(`invalid timestamp for signature "test" in github.com/mandelsoft/test:v1: certificate "CN=other" of timestamp authority is not restricted to timestamping`))
+	})
+})
@@ -0,0 +1,234 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package journal
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Journal records the progress of a transfer in a state file.
+// It is used to resume an interrupted transfer without repeating
+// already completed work.
+// All methods can be called on a nil journal, which then
+// just does nothing.
+type Journal struct {
+	lock  sync.Mutex
+	fs    vfs.FileSystem
+	path  string
+	state State
+}
+
+// State is the persisted state of a transfer journal.
+type State struct {
+	Versions map[string]*Version `json:"versions,omitempty"`
+}
+
+// Version describes the transfer state of a component version.
+type Version struct {
+	Completed bool              `json:"completed,omitempty"`
+	Resources map[int]*Artefact `json:"resources,omitempty"`
+	Sources   map[int]*Artefact `json:"sources,omitempty"`
+}
+
+// Artefact describes an artefact blob already stored in the target.
+type Artefact struct {
+	Digest digest.Digest                    `json:"digest,omitempty"`
+	Access *runtime.UnstructuredTypedObject `json:"access"`
+}
+
+// Open provides a journal for the given state file.
+// If resume is set, the state of an existing file is
+// loaded, otherwise the journal starts with an empty state.
+func Open(fs vfs.FileSystem, path string, resume bool) (*Journal, error) {
+	j := &Journal{
+		fs:   fs,
+		path: path,
+	}
+	if resume {
+		data, err := vfs.ReadFile(fs, path)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return nil, errors.Wrapf(err, "cannot read transfer journal %q", path)
+			}
+		} else {
+			err = json.Unmarshal(data, &j.state)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid transfer journal %q", path)
+			}
+		}
+	}
+	return j, nil
+}
+
+func (j *Journal) GetPath() string {
+	if j == nil {
+		return ""
+	}
+	return j.path
+}
+
+// Remove deletes the state file of the journal.
+func (j *Journal) Remove() error {
+	if j == nil {
+		return nil
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	j.state = State{}
+	err := j.fs.Remove(j.path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (j *Journal) IsCompleted(nv common.NameVersion) bool {
+	if j == nil {
+		return false
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	v := j.state.Versions[nv.String()]
+	return v != nil && v.Completed
+}
+
+// IsIncomplete reports whether the transfer of a component version
+// has been started, but not been completed.
+func (j *Journal) IsIncomplete(nv common.NameVersion) bool {
+	if j == nil {
+		return false
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	v := j.state.Versions[nv.String()]
+	return v != nil && !v.Completed
+}
+
+// Start records the start of the transfer of a component version.
+// Artefacts recorded by an earlier attempt are kept.
+func (j *Journal) Start(nv common.NameVersion) error {
+	if j == nil {
+		return nil
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	v := j.getVersion(nv)
+	v.Completed = false
+	return j.save()
+}
+
+// SetCompleted marks a component version as completely transferred.
+// The artefact information of this version is not required anymore.
+func (j *Journal) SetCompleted(nv common.NameVersion) error {
+	if j == nil {
+		return nil
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if j.state.Versions == nil {
+		j.state.Versions = map[string]*Version{}
+	}
+	j.state.Versions[nv.String()] = &Version{Completed: true}
+	return j.save()
+}
+
+func (j *Journal) GetResource(nv common.NameVersion, index int) *Artefact {
+	if j == nil {
+		return nil
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if v := j.state.Versions[nv.String()]; v != nil {
+		return v.Resources[index]
+	}
+	return nil
+}
+
+func (j *Journal) SetResource(nv common.NameVersion, index int, a *Artefact) error {
+	if j == nil {
+		return nil
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	v := j.getVersion(nv)
+	if v.Resources == nil {
+		v.Resources = map[int]*Artefact{}
+	}
+	v.Resources[index] = a
+	return j.save()
+}
+
+func (j *Journal) GetSource(nv common.NameVersion, index int) *Artefact {
+	if j == nil {
+		return nil
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if v := j.state.Versions[nv.String()]; v != nil {
+		return v.Sources[index]
+	}
+	return nil
+}
+
+func (j *Journal) SetSource(nv common.NameVersion, index int, a *Artefact) error {
+	if j == nil {
+		return nil
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	v := j.getVersion(nv)
+	if v.Sources == nil {
+		v.Sources = map[int]*Artefact{}
+	}
+	v.Sources[index] = a
+	return j.save()
+}
+
+func (j *Journal) getVersion(nv common.NameVersion) *Version {
+	if j.state.Versions == nil {
+		j.state.Versions = map[string]*Version{}
+	}
+	v := j.state.Versions[nv.String()]
+	if v == nil {
+		v = &Version{}
+		j.state.Versions[nv.String()] = v
+	}
+	return v
+}
+
+func (j *Journal) save() error {
+	data, err := json.Marshal(&j.state)
+	if err != nil {
+		return err
+	}
+	err = j.fs.MkdirAll(vfs.Dir(j.fs, j.path), 0o700)
+	if err != nil {
+		return errors.Wrapf(err, "cannot create directory for transfer journal %q", j.path)
+	}
+	err = vfs.WriteFile(j.fs, j.path, data, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "cannot write transfer journal %q", j.path)
+	}
+	return nil
+}
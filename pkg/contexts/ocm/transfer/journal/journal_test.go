@@ -0,0 +1,92 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package journal_test
+
+import (
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localblob"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const PATH = "/state/journal.json"
+
+var _ = Describe("transfer journal", func() {
+	var fs vfs.FileSystem
+	var nv = common.NewNameVersion("acme.org/test", "v1")
+
+	BeforeEach(func() {
+		fs = memoryfs.New()
+	})
+
+	It("persists the transfer progress", func() {
+		j, err := journal.Open(fs, PATH, false)
+		Expect(err).To(Succeed())
+		Expect(j.IsIncomplete(nv)).To(BeFalse())
+		Expect(j.Start(nv)).To(Succeed())
+
+		acc, err := runtime.ToUnstructuredTypedObject(localblob.New("sha256:0815", "", "text/plain", nil))
+		Expect(err).To(Succeed())
+		Expect(j.SetResource(nv, 1, &journal.Artefact{Digest: "sha256:0815", Access: acc})).To(Succeed())
+		Expect(vfs.FileExists(fs, PATH)).To(BeTrue())
+
+		j, err = journal.Open(fs, PATH, true)
+		Expect(err).To(Succeed())
+		Expect(j.IsIncomplete(nv)).To(BeTrue())
+		Expect(j.IsCompleted(nv)).To(BeFalse())
+		Expect(j.GetResource(nv, 0)).To(BeNil())
+		a := j.GetResource(nv, 1)
+		Expect(a).NotTo(BeNil())
+		Expect(a.Digest.String()).To(Equal("sha256:0815"))
+		Expect(a.Access.GetType()).To(Equal(localblob.Type))
+
+		Expect(j.SetCompleted(nv)).To(Succeed())
+		j, err = journal.Open(fs, PATH, true)
+		Expect(err).To(Succeed())
+		Expect(j.IsCompleted(nv)).To(BeTrue())
+		Expect(j.GetResource(nv, 1)).To(BeNil())
+	})
+
+	It("starts with an empty state without resume", func() {
+		j, err := journal.Open(fs, PATH, false)
+		Expect(err).To(Succeed())
+		Expect(j.SetCompleted(nv)).To(Succeed())
+
+		j, err = journal.Open(fs, PATH, false)
+		Expect(err).To(Succeed())
+		Expect(j.IsCompleted(nv)).To(BeFalse())
+	})
+
+	It("removes the journal", func() {
+		j, err := journal.Open(fs, PATH, false)
+		Expect(err).To(Succeed())
+		Expect(j.SetCompleted(nv)).To(Succeed())
+		Expect(j.Remove()).To(Succeed())
+		Expect(vfs.FileExists(fs, PATH)).To(BeFalse())
+		Expect(j.Remove()).To(Succeed())
+	})
+
+	It("handles a nil journal", func() {
+		var j *journal.Journal
+		Expect(j.SetCompleted(nv)).To(Succeed())
+		Expect(j.IsCompleted(nv)).To(BeFalse())
+		Expect(j.GetResource(nv, 0)).To(BeNil())
+	})
+})
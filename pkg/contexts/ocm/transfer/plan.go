@@ -0,0 +1,254 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package transfer
+
+import (
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localblob"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/none"
+	ocmcpi "github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// Actions planned for a component version.
+const (
+	PLAN_COPY      = "copy"
+	PLAN_OVERWRITE = "overwrite"
+	PLAN_RESUME    = "resume"
+	PLAN_SKIP      = "skip"
+)
+
+// Transfer modes planned for a resource or source.
+const (
+	MODE_LOCAL     = "local"
+	MODE_VALUE     = "value"
+	MODE_REFERENCE = "reference"
+)
+
+// VersionPlan describes the planned transfer of a component version.
+type VersionPlan struct {
+	Component string          `json:"component"`
+	Version   string          `json:"version"`
+	History   common.History  `json:"history,omitempty"`
+	Action    string          `json:"action"`
+	Resources []*ArtefactPlan `json:"resources,omitempty"`
+	Sources   []*ArtefactPlan `json:"sources,omitempty"`
+}
+
+func (p *VersionPlan) AsManifest() interface{} {
+	return p
+}
+
+// ArtefactPlan describes the planned transfer of a resource or source.
+// For artefacts copied into the target, the expected access type
+// and, if uploaded as separate OCI artefact, the expected
+// target OCI repository are given.
+type ArtefactPlan struct {
+	Index        int    `json:"index"`
+	Name         string `json:"name"`
+	Version      string `json:"version,omitempty"`
+	Type         string `json:"type"`
+	Access       string `json:"access"`
+	Mode         string `json:"mode,omitempty"`
+	TargetAccess string `json:"targetAccess,omitempty"`
+	TargetRef    string `json:"targetRef,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// PlanVersion evaluates the decisions of the given transfer handler for
+// the transfer of a component version (and the versions it
+// requires) without writing anything to the target repository.
+func PlanVersion(closure TransportClosure, src ocmcpi.ComponentVersionAccess, tgt ocmcpi.Repository, handler transferhandler.TransferHandler) ([]*VersionPlan, error) {
+	if closure == nil {
+		closure = TransportClosure{}
+	}
+	if handler == nil {
+		var err error
+		handler, err = standard.New(standard.Overwrite())
+		if err != nil {
+			return nil, err
+		}
+	}
+	var plans []*VersionPlan
+	state := common.WalkingState{Closure: closure}
+	err := planVersion(&plans, getJournal(handler), state, src, tgt, handler)
+	return plans, err
+}
+
+func planVersion(plans *[]*VersionPlan, j *journal.Journal, state common.WalkingState, src ocmcpi.ComponentVersionAccess, tgt ocmcpi.Repository, handler transferhandler.TransferHandler) error {
+	nv := common.VersionedElementKey(src)
+	if ok, err := state.Add(ocm.KIND_COMPONENTVERSION, nv); !ok {
+		return err
+	}
+
+	plan := &VersionPlan{
+		Component: src.GetName(),
+		Version:   src.GetVersion(),
+		Action:    PLAN_COPY,
+	}
+	if len(state.History) > 1 {
+		plan.History = state.History[:len(state.History)-1].Copy()
+	}
+	*plans = append(*plans, plan)
+
+	action, err := planAction(j, nv, src, tgt, handler)
+	if err != nil {
+		return errors.Wrapf(err, "%s: lookup target version", state.History)
+	}
+	plan.Action = action
+	if action == PLAN_SKIP {
+		return nil
+	}
+
+	for i, r := range src.GetResources() {
+		a := planArtefact(i, r, r.Meta().GetName(), r.Meta().GetVersion(), r.Meta().GetType(), src, tgt, func(a ocm.AccessSpec) (bool, error) {
+			return handler.TransferResource(src, a, r)
+		})
+		plan.Resources = append(plan.Resources, a)
+	}
+	for i, r := range src.GetSources() {
+		a := planArtefact(i, r, r.Meta().GetName(), r.Meta().GetVersion(), r.Meta().GetType(), src, tgt, func(a ocm.AccessSpec) (bool, error) {
+			return handler.TransferSource(src, a, r)
+		})
+		plan.Sources = append(plan.Sources, a)
+	}
+
+	list := errors.ErrListf("component references for %s", nv)
+	d := src.GetDescriptor()
+	for _, r := range d.References {
+		cv, shdlr, err := handler.TransferVersion(src.Repository(), src, &r)
+		if err != nil {
+			return errors.Wrapf(err, "%s: nested component %s[%s:%s]", state.History, r.GetName(), r.ComponentName, r.GetVersion())
+		}
+		if cv != nil {
+			list.Add(planVersion(plans, j, state, cv, tgt, shdlr))
+			cv.Close()
+		}
+	}
+	return list.Result()
+}
+
+func planAction(j *journal.Journal, nv common.NameVersion, src ocmcpi.ComponentVersionAccess, tgt ocmcpi.Repository, handler transferhandler.TransferHandler) (string, error) {
+	comp, err := tgt.LookupComponent(src.GetName())
+	if err != nil {
+		return "", err
+	}
+	defer comp.Close()
+
+	t, err := comp.LookupVersion(src.GetVersion())
+	if err != nil {
+		if errors.IsErrNotFound(err) {
+			return PLAN_COPY, nil
+		}
+		return "", err
+	}
+	t.DiscardChanges()
+	defer t.Close()
+
+	switch {
+	case j.IsCompleted(nv):
+		return PLAN_SKIP, nil
+	case j.IsIncomplete(nv):
+		return PLAN_RESUME, nil
+	}
+	ok, err := handler.OverwriteVersion(src, t)
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return PLAN_OVERWRITE, nil
+	}
+	return PLAN_SKIP, nil
+}
+
+type accessProvider interface {
+	Access() (ocm.AccessSpec, error)
+	AccessMethod() (ocm.AccessMethod, error)
+}
+
+func planArtefact(i int, r accessProvider, name, vers, typ string, src ocmcpi.ComponentVersionAccess, tgt ocmcpi.Repository, byValue func(ocm.AccessSpec) (bool, error)) *ArtefactPlan {
+	plan := &ArtefactPlan{
+		Index:   i,
+		Name:    name,
+		Version: vers,
+		Type:    typ,
+	}
+	a, err := r.Access()
+	if err != nil {
+		plan.Error = err.Error()
+		return plan
+	}
+	plan.Access = a.GetKind()
+
+	ok := a.IsLocal(src.GetContext())
+	if ok {
+		plan.Mode = MODE_LOCAL
+	} else {
+		if a.GetKind() != none.Type {
+			ok, err = byValue(a)
+			if err != nil {
+				plan.Error = err.Error()
+				return plan
+			}
+		}
+		if ok {
+			plan.Mode = MODE_VALUE
+		} else {
+			plan.Mode = MODE_REFERENCE
+			plan.TargetAccess = a.GetKind()
+			return plan
+		}
+	}
+
+	m, err := r.AccessMethod()
+	if err != nil {
+		plan.Error = err.Error()
+		return plan
+	}
+	defer m.Close()
+	plan.TargetAccess, plan.TargetRef, err = planTarget(tgt, src.GetName(), m.MimeType(), ocmcpi.ArtefactNameHint(a, src))
+	if err != nil {
+		plan.Error = err.Error()
+	}
+	return plan
+}
+
+// planTarget determines the access type and reference expected for
+// a blob stored in the target repository. The decision is taken
+// from the blob handlers registered for the target repository,
+// which are asked to plan the storage instead of storing the blob.
+// Blobs not handled by any handler are stored as local blobs.
+func planTarget(tgt ocmcpi.Repository, name string, mime string, hint string) (string, string, error) {
+	p, ok := tgt.(ocmcpi.PlanningContextProvider)
+	if !ok {
+		return "", "", errors.ErrNotSupported("transfer planning", tgt.GetSpecification().GetKind())
+	}
+	ctx, err := p.GetPlanningContext(name)
+	if err != nil {
+		return "", "", err
+	}
+	h := tgt.GetContext().BlobHandlers().GetHandler(ctx.GetImplementationRepositoryType(), mime)
+	if h != nil {
+		kind, ref, err := ocmcpi.PlanBlob(h, mime, hint, ctx)
+		if err != nil || kind != "" {
+			return kind, ref, err
+		}
+	}
+	return localblob.Type, "", nil
+}
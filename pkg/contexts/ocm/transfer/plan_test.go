@@ -0,0 +1,218 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package transfer_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/env"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/grammar"
+	ctfoci "github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localblob"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartefact"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/ociuploadattr"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const UPLOAD = "/tmp/upload"
+
+type storingHandler struct{}
+
+func (h *storingHandler) StoreBlob(blob cpi.BlobAccess, hint string, global cpi.AccessSpec, ctx cpi.StorageContext) (cpi.AccessSpec, error) {
+	return nil, nil
+}
+
+type planningHandler struct {
+	storingHandler
+}
+
+func (h *planningHandler) PlanBlob(mimeType string, hint string, ctx cpi.PlanningContext) (string, string, error) {
+	return "custom", ctx.TargetComponentName(), nil
+}
+
+var _ = Describe("planning transfers", func() {
+	var env *Builder
+
+	BeforeEach(func() {
+		env = NewBuilder(NewEnvironment())
+
+		env.OCICommonTransport(OCIPATH, accessio.FormatDirectory, func() {
+			env.Namespace(OCINAMESPACE, func() {
+				env.Manifest(OCIVERSION, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "manifestlayer")
+					})
+				})
+			})
+		})
+		env.OCICommonTransport(UPLOAD, accessio.FormatDirectory)
+
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Component(COMPONENT, func() {
+				env.Version(VERSION, func() {
+					env.Provider(PROVIDER)
+					env.Resource("testdata", "", "PlainText", metav1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata")
+					})
+					env.Resource("artefact", "", resourcetypes.OCI_IMAGE, metav1.LocalRelation, func() {
+						env.Access(
+							ociartefact.New(oci.StandardOCIRef(OCIHOST+".alias", OCINAMESPACE, OCIVERSION)),
+						)
+					})
+				})
+			})
+		})
+
+		env.OCIContext().SetAlias(OCIHOST, ctfoci.NewRepositorySpec(accessobj.ACC_READONLY, OCIPATH, accessio.PathFileSystem(env.FileSystem())))
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("plans a transfer without touching the target", func() {
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		defer src.Close()
+		cv, err := src.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer cv.Close()
+		tgt, err := ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env)
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+
+		plans, err := transfer.PlanVersion(nil, cv, tgt, NewHandler(nil))
+		Expect(err).To(Succeed())
+		Expect(plans).To(Equal([]*transfer.VersionPlan{
+			{
+				Component: COMPONENT,
+				Version:   VERSION,
+				Action:    transfer.PLAN_COPY,
+				Resources: []*transfer.ArtefactPlan{
+					{Index: 0, Name: "testdata", Version: VERSION, Type: "PlainText", Access: localblob.Type, Mode: transfer.MODE_LOCAL, TargetAccess: localblob.Type},
+					{Index: 1, Name: "artefact", Version: VERSION, Type: resourcetypes.OCI_IMAGE, Access: ociartefact.Type, Mode: transfer.MODE_VALUE, TargetAccess: localblob.Type},
+				},
+			},
+		}))
+		list, err := tgt.ComponentLister().GetComponents("", true)
+		Expect(err).To(Succeed())
+		Expect(list).To(BeEmpty())
+
+		plans, err = transfer.PlanVersion(nil, cv, tgt, nil)
+		Expect(err).To(Succeed())
+		Expect(plans[0].Resources[1].Mode).To(Equal(transfer.MODE_REFERENCE))
+		Expect(plans[0].Resources[1].TargetAccess).To(Equal(ociartefact.Type))
+	})
+
+	It("plans skipping and overwriting existing versions", func() {
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		defer src.Close()
+		cv, err := src.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer cv.Close()
+		tgt, err := ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env)
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+		Expect(transfer.TransferVersion(nil, nil, cv, tgt, NewHandler(nil))).To(Succeed())
+
+		plans, err := transfer.PlanVersion(nil, cv, tgt, NewHandler(nil))
+		Expect(err).To(Succeed())
+		Expect(len(plans)).To(Equal(1))
+		Expect(plans[0].Action).To(Equal(transfer.PLAN_SKIP))
+		Expect(plans[0].Resources).To(BeNil())
+
+		plans, err = transfer.PlanVersion(nil, cv, tgt, NewHandler(nil, standard.Overwrite()))
+		Expect(err).To(Succeed())
+		Expect(plans[0].Action).To(Equal(transfer.PLAN_OVERWRITE))
+		Expect(len(plans[0].Resources)).To(Equal(2))
+	})
+
+	It("plans the upload of OCI artefacts", func() {
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		defer src.Close()
+		cv, err := src.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer cv.Close()
+		tgt, err := ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env)
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+
+		attr := ociuploadattr.New(UPLOAD + grammar.RepositorySeparator + grammar.RepositorySeparator + "copy")
+		defer attr.Close()
+		ociuploadattr.Set(env.OCMContext(), attr)
+
+		plans, err := transfer.PlanVersion(nil, cv, tgt, NewHandler(nil))
+		Expect(err).To(Succeed())
+		Expect(plans[0].Resources[0].TargetAccess).To(Equal(localblob.Type))
+		Expect(plans[0].Resources[1].TargetAccess).To(Equal(ociartefact.Type))
+		Expect(plans[0].Resources[1].TargetRef).To(Equal("/tmp/upload//copy/oci/test:v2.0"))
+	})
+
+	It("plans according to the registered blob handlers", func() {
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		defer src.Close()
+		cv, err := src.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer cv.Close()
+
+		ctx := ocm.WithBlobHandlers(ocm.DefaultBlobHandlers().Copy().Register(&planningHandler{}, cpi.ForMimeType(mime.MIME_TEXT))).New()
+		tgt, err := ctf.Create(ctx, accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env)
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+
+		plans, err := transfer.PlanVersion(nil, cv, tgt, NewHandler(nil))
+		Expect(err).To(Succeed())
+		Expect(plans[0].Resources[0].TargetAccess).To(Equal("custom"))
+		Expect(plans[0].Resources[0].TargetRef).To(Equal(COMPONENT))
+		Expect(plans[0].Resources[1].TargetAccess).To(Equal(localblob.Type))
+	})
+
+	It("reports blob handlers not supporting planning", func() {
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		defer src.Close()
+		cv, err := src.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer cv.Close()
+
+		ctx := ocm.WithBlobHandlers(ocm.DefaultBlobHandlers().Copy().Register(&storingHandler{}, cpi.ForMimeType(mime.MIME_TEXT))).New()
+		tgt, err := ctf.Create(ctx, accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env)
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+
+		plans, err := transfer.PlanVersion(nil, cv, tgt, NewHandler(nil))
+		Expect(err).To(Succeed())
+		Expect(plans[0].Resources[0].TargetAccess).To(Equal(""))
+		Expect(plans[0].Resources[0].Error).To(ContainSubstring("blob planning"))
+	})
+})
@@ -0,0 +1,128 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package transfer
+
+import (
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localblob"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+func getJournal(handler transferhandler.TransferHandler) *journal.Journal {
+	if h, ok := handler.(transferhandler.JournalHandler); ok {
+		return h.GetJournal()
+	}
+	return nil
+}
+
+func recordResource(j *journal.Journal, nv common.NameVersion, index int, t ocm.ComponentVersionAccess) error {
+	if j == nil {
+		return nil
+	}
+	r, err := t.GetResourceByIndex(index)
+	if err != nil {
+		return err
+	}
+	spec, err := r.Access()
+	if err != nil {
+		return err
+	}
+	a, err := journalArtefact(spec)
+	if err != nil {
+		return err
+	}
+	return j.SetResource(nv, index, a)
+}
+
+func recordSource(j *journal.Journal, nv common.NameVersion, index int, t ocm.ComponentVersionAccess) error {
+	if j == nil {
+		return nil
+	}
+	r, err := t.GetSourceByIndex(index)
+	if err != nil {
+		return err
+	}
+	spec, err := r.Access()
+	if err != nil {
+		return err
+	}
+	a, err := journalArtefact(spec)
+	if err != nil {
+		return err
+	}
+	return j.SetSource(nv, index, a)
+}
+
+func journalArtefact(spec ocm.AccessSpec) (*journal.Artefact, error) {
+	acc, err := runtime.ToUnstructuredTypedObject(spec)
+	if err != nil {
+		return nil, err
+	}
+	a := &journal.Artefact{Access: acc}
+	if l, ok := spec.(*localblob.AccessSpec); ok {
+		if ok, _ := artdesc.IsDigest(l.LocalReference); ok {
+			a.Digest = digest.Digest(l.LocalReference)
+		}
+	}
+	return a, nil
+}
+
+func reuseResource(a *journal.Artefact, r ocm.ResourceAccess, hint string, t ocm.ComponentVersionAccess) bool {
+	return reuseArtefact(a, t, func(blob accessio.BlobAccess) error {
+		return t.SetResourceBlob(r.Meta(), blob, hint, nil)
+	})
+}
+
+func reuseSource(a *journal.Artefact, r ocm.SourceAccess, hint string, t ocm.ComponentVersionAccess) bool {
+	return reuseArtefact(a, t, func(blob accessio.BlobAccess) error {
+		return t.SetSourceBlob(r.Meta(), blob, hint, nil)
+	})
+}
+
+// reuseArtefact uses an artefact blob already transferred to the target
+// by an earlier transfer. The blob is only reused, if its digest
+// in the target still matches the recorded one. Otherwise, the
+// artefact has to be transferred again.
+func reuseArtefact(a *journal.Artefact, t ocm.ComponentVersionAccess, set func(accessio.BlobAccess) error) bool {
+	if a == nil || a.Access == nil {
+		return false
+	}
+	raw, err := a.Access.GetRaw()
+	if err != nil {
+		return false
+	}
+	spec, err := t.GetContext().AccessSpecForConfig(raw, nil)
+	if err != nil {
+		return false
+	}
+	m, err := t.AccessMethod(spec)
+	if err != nil {
+		return false
+	}
+	defer m.Close()
+	blob := accessio.BlobAccessForDataAccess("", -1, m.MimeType(), m)
+	d := blob.Digest()
+	if d == "" || (a.Digest != "" && a.Digest != d) {
+		return false
+	}
+	return set(blob) == nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package transfer_test
+
+import (
+	"bytes"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/env"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	ctfoci "github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartefact"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const ARCH = "/tmp/ctf"
+const PROVIDER = "mandelsoft"
+const VERSION = "v1"
+const COMPONENT = "github.com/mandelsoft/test"
+const OUT = "/tmp/res"
+const OCIPATH = "/tmp/oci"
+const OCINAMESPACE = "oci/test"
+const OCIVERSION = "v2.0"
+const OCIHOST = "alias"
+const JOURNAL = "/tmp/journal.json"
+
+// failingHandler fails the transfer of a dedicated resource.
+type failingHandler struct {
+	*standard.Handler
+	fail string
+}
+
+func (h *failingHandler) HandleTransferResource(r ocm.ResourceAccess, m ocm.AccessMethod, hint string, t ocm.ComponentVersionAccess) error {
+	if r.Meta().GetName() == h.fail {
+		return fmt.Errorf("network failure")
+	}
+	return h.Handler.HandleTransferResource(r, m, hint, t)
+}
+
+func NewHandler(j *journal.Journal, opts ...transferhandler.TransferOption) *standard.Handler {
+	o := &standard.Options{}
+	Expect(transferhandler.ApplyOptions(o, append(opts, standard.ResourcesByValue(), standard.Journal(j))...)).To(Succeed())
+	return standard.NewDefaultHandler(o)
+}
+
+var _ = Describe("resuming transfers", func() {
+	var env *Builder
+	var nv = common.NewNameVersion(COMPONENT, VERSION)
+
+	BeforeEach(func() {
+		env = NewBuilder(NewEnvironment())
+
+		env.OCICommonTransport(OCIPATH, accessio.FormatDirectory, func() {
+			env.Namespace(OCINAMESPACE, func() {
+				env.Manifest(OCIVERSION, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "manifestlayer")
+					})
+				})
+			})
+		})
+
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Component(COMPONENT, func() {
+				env.Version(VERSION, func() {
+					env.Provider(PROVIDER)
+					env.Resource("testdata", "", "PlainText", metav1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata")
+					})
+					env.Resource("artefact", "", resourcetypes.OCI_IMAGE, metav1.LocalRelation, func() {
+						env.Access(
+							ociartefact.New(oci.StandardOCIRef(OCIHOST+".alias", OCINAMESPACE, OCIVERSION)),
+						)
+					})
+				})
+			})
+		})
+
+		env.OCIContext().SetAlias(OCIHOST, ctfoci.NewRepositorySpec(accessobj.ACC_READONLY, OCIPATH, accessio.PathFileSystem(env.FileSystem())))
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("continues an interrupted transfer", func() {
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		defer src.Close()
+		cv, err := src.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer cv.Close()
+		tgt, err := ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env)
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+
+		j, err := journal.Open(env.FileSystem(), JOURNAL, false)
+		Expect(err).To(Succeed())
+		handler := &failingHandler{NewHandler(j), "artefact"}
+		err = transfer.TransferVersion(nil, nil, cv, tgt, handler)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("network failure"))
+		Expect(j.IsIncomplete(nv)).To(BeTrue())
+		Expect(j.GetResource(nv, 0)).NotTo(BeNil())
+
+		buf := bytes.NewBuffer(nil)
+		j, err = journal.Open(env.FileSystem(), JOURNAL, true)
+		Expect(err).To(Succeed())
+		err = transfer.TransferVersion(common.NewPrinter(buf), nil, cv, tgt, NewHandler(j))
+		Expect(err).To(Succeed())
+		Expect(buf.String()).To(Equal(`transferring version "github.com/mandelsoft/test:v1"...
+...resource 0 already transferred...
+...resource 1(oci/test:v2.0)...
+...adding component version...
+`))
+		Expect(j.IsCompleted(nv)).To(BeTrue())
+
+		comp, err := tgt.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer comp.Close()
+		Expect(len(comp.GetDescriptor().Resources)).To(Equal(2))
+		r, err := comp.GetResourceByIndex(0)
+		Expect(err).To(Succeed())
+		data, err := ocm.ResourceData(r)
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(Equal("testdata"))
+
+		buf.Reset()
+		j, err = journal.Open(env.FileSystem(), JOURNAL, true)
+		Expect(err).To(Succeed())
+		err = transfer.TransferVersion(common.NewPrinter(buf), nil, cv, tgt, NewHandler(j, standard.Overwrite()))
+		Expect(err).To(Succeed())
+		Expect(buf.String()).To(Equal(`transferring version "github.com/mandelsoft/test:v1"...
+  version "github.com/mandelsoft/test:v1" already transferred -> skip transport
+`))
+	})
+})
@@ -20,6 +20,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/none"
 	ocmcpi "github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
 	"github.com/open-component-model/ocm/pkg/errors"
@@ -36,12 +37,12 @@ func TransferVersion(printer common.Printer, closure TransportClosure, src ocmcp
 		printer = common.NewPrinter(nil)
 	}
 	state := common.WalkingState{Closure: closure}
-	return transferVersion(printer, state, src, tgt, handler)
+	return transferVersion(printer, newWorkers(handler), getJournal(handler), state, src, tgt, handler)
 }
 
-func transferVersion(printer common.Printer, state common.WalkingState, src ocmcpi.ComponentVersionAccess, tgt ocmcpi.Repository, handler transferhandler.TransferHandler) error {
+func transferVersion(printer common.Printer, w *workers, j *journal.Journal, state common.WalkingState, src ocmcpi.ComponentVersionAccess, tgt ocmcpi.Repository, handler transferhandler.TransferHandler) error {
 	nv := common.VersionedElementKey(src)
-	if ok, err := state.Add(ocm.KIND_COMPONENTVERSION, nv); !ok {
+	if ok, err := w.Add(&state, ocm.KIND_COMPONENTVERSION, nv); !ok {
 		return err
 	}
 	printer.Printf("transferring version %q...\n", nv)
@@ -70,7 +71,16 @@ func transferVersion(printer common.Printer, state common.WalkingState, src ocmc
 		}
 	} else {
 		var ok bool
-		ok, err = handler.OverwriteVersion(src, t)
+		switch {
+		case j.IsCompleted(nv):
+			printer.Printf("  version %q already transferred -> skip transport\n", nv)
+			return nil
+		case j.IsIncomplete(nv):
+			// continue an interrupted transfer
+			ok = true
+		default:
+			ok, err = handler.OverwriteVersion(src, t)
+		}
 		if !ok {
 			printer.Printf("  version %q already present -> skip transport\n", nv)
 			return nil
@@ -80,22 +90,32 @@ func transferVersion(printer common.Printer, state common.WalkingState, src ocmc
 		return errors.Wrapf(err, "%s: creating target version", state.History)
 	}
 
-	err = CopyVersion(printer, state.History, src, t, handler)
+	err = j.Start(nv)
+	if err != nil {
+		return err
+	}
+	err = copyVersion(printer, w, j, state.History, src, t, handler)
 	if err != nil {
 		return err
 	}
 	subp := printer.AddGap("  ")
 	list := errors.ErrListf("component references for %s", nv)
+	refs := w.NewGroup(false)
 	for _, r := range d.References {
 		cv, shdlr, err := handler.TransferVersion(src.Repository(), src, &r)
 		if err != nil {
+			refs.Wait()
 			return errors.Wrapf(err, "%s: nested component %s[%s:%s]", state.History, r.GetName(), r.ComponentName, r.GetVersion())
 		}
 		if cv != nil {
-			list.Add(transferVersion(subp, state, cv, tgt, shdlr))
-			cv.Close()
+			sub := common.WalkingState{Closure: state.Closure, History: state.History.Copy()}
+			refs.Run(func() error {
+				defer cv.Close()
+				return transferVersion(subp, w, j, sub, cv, tgt, shdlr)
+			})
 		}
 	}
+	list.Add(refs.Wait()...)
 
 	var unstr *runtime.UnstructuredTypedObject
 	if !ocm.IsIntermediate(tgt.GetSpecification()) {
@@ -110,78 +130,129 @@ func transferVersion(printer common.Printer, state common.WalkingState, src ocmc
 	}
 	cd.Signatures = src.GetDescriptor().Signatures.Copy()
 	printer.Printf("...adding component version...\n")
-	return list.Add(comp.AddVersion(t)).Result()
+	err = list.Add(comp.AddVersion(t)).Result()
+	if err != nil {
+		return err
+	}
+	return j.SetCompleted(nv)
 }
 
 func CopyVersion(printer common.Printer, hist common.History, src ocm.ComponentVersionAccess, t ocm.ComponentVersionAccess, handler transferhandler.TransferHandler) error {
+	return copyVersion(printer, newWorkers(handler), getJournal(handler), hist, src, t, handler)
+}
+
+func copyVersion(printer common.Printer, w *workers, j *journal.Journal, hist common.History, src ocm.ComponentVersionAccess, t ocm.ComponentVersionAccess, handler transferhandler.TransferHandler) error {
 	if handler == nil {
 		handler = standard.NewDefaultHandler(nil)
 	}
 
 	*t.GetDescriptor() = *src.GetDescriptor().Copy()
+	blobs := w.NewGroup(true)
 	for i, r := range src.GetResources() {
-		var m ocm.AccessMethod
-		a, err := r.Access()
+		i, r := i, r
+		err := blobs.Run(func() error {
+			return copyResource(printer, j, hist, i, r, src, t, handler)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for i, r := range src.GetSources() {
+		i, r := i, r
+		err := blobs.Run(func() error {
+			return copySource(printer, j, hist, i, r, src, t, handler)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return errors.ErrListf("%s: transferring artefacts", hist).Add(blobs.Wait()...).Result()
+}
+
+func copyResource(printer common.Printer, j *journal.Journal, hist common.History, i int, r ocm.ResourceAccess, src ocm.ComponentVersionAccess, t ocm.ComponentVersionAccess, handler transferhandler.TransferHandler) error {
+	var m ocm.AccessMethod
+	a, err := r.Access()
+	if err == nil {
+		m, err = r.AccessMethod()
 		if err == nil {
-			m, err = r.AccessMethod()
-			if err == nil {
-				defer m.Close()
-				ok := a.IsLocal(src.GetContext())
-				if !ok {
-					if a.GetKind() != none.Type {
-						ok, err = handler.TransferResource(src, a, r)
-					}
+			defer m.Close()
+			ok := a.IsLocal(src.GetContext())
+			if !ok {
+				if a.GetKind() != none.Type {
+					ok, err = handler.TransferResource(src, a, r)
 				}
-				if ok {
-					hint := ocmcpi.ArtefactNameHint(a, src)
-					printArtefactInfo(printer, "resource", i, hint)
-					err = handler.HandleTransferResource(r, m, hint, t)
+			}
+			if ok {
+				hint := ocmcpi.ArtefactNameHint(a, src)
+				nv := common.VersionedElementKey(src)
+				if reuseResource(j.GetResource(nv, i), r, hint, t) {
+					printArtefactInfo(printer, "resource", i, hint, "already transferred")
+					return nil
+				}
+				printArtefactInfo(printer, "resource", i, hint)
+				err = handler.HandleTransferResource(r, m, hint, t)
+				if err == nil {
+					err = recordResource(j, nv, i, t)
 				}
 			}
 		}
-		if err != nil {
-			if !errors.IsErrUnknownKind(err, errors.KIND_ACCESSMETHOD) {
-				return errors.Wrapf(err, "%s: transferring resource %d", hist, i)
-			}
-			printer.Printf("WARN: %s: transferring resource %d: %s (enforce transport by reference)\n", hist, i, err)
+	}
+	if err != nil {
+		if !errors.IsErrUnknownKind(err, errors.KIND_ACCESSMETHOD) {
+			return errors.Wrapf(err, "%s: transferring resource %d", hist, i)
 		}
+		printer.Printf("WARN: %s: transferring resource %d: %s (enforce transport by reference)\n", hist, i, err)
 	}
-	for i, r := range src.GetSources() {
-		var m ocm.AccessMethod
-		a, err := r.Access()
+	return nil
+}
+
+func copySource(printer common.Printer, j *journal.Journal, hist common.History, i int, r ocm.SourceAccess, src ocm.ComponentVersionAccess, t ocm.ComponentVersionAccess, handler transferhandler.TransferHandler) error {
+	var m ocm.AccessMethod
+	a, err := r.Access()
+	if err == nil {
+		m, err = r.AccessMethod()
 		if err == nil {
-			m, err = r.AccessMethod()
-			if err == nil {
-				defer m.Close()
-				ok := a.IsLocal(src.GetContext())
-				if !ok {
-					if a.GetKind() != none.Type {
-						ok, err = handler.TransferSource(src, a, r)
-					}
+			defer m.Close()
+			ok := a.IsLocal(src.GetContext())
+			if !ok {
+				if a.GetKind() != none.Type {
+					ok, err = handler.TransferSource(src, a, r)
 				}
-				if ok {
-					hint := ocmcpi.ArtefactNameHint(a, src)
-					printArtefactInfo(printer, "source", i, hint)
-					err = handler.HandleTransferSource(r, m, hint, t)
+			}
+			if ok {
+				hint := ocmcpi.ArtefactNameHint(a, src)
+				nv := common.VersionedElementKey(src)
+				if reuseSource(j.GetSource(nv, i), r, hint, t) {
+					printArtefactInfo(printer, "source", i, hint, "already transferred")
+					return nil
+				}
+				printArtefactInfo(printer, "source", i, hint)
+				err = handler.HandleTransferSource(r, m, hint, t)
+				if err == nil {
+					err = recordSource(j, nv, i, t)
 				}
 			}
 		}
-		if err != nil {
-			if !errors.IsErrUnknownKind(err, errors.KIND_ACCESSMETHOD) {
-				return errors.Wrapf(err, "%s: transferring source %d", hist, i)
-			}
-			printer.Printf("WARN: %s: transferring source %d: %s (enforce transport by reference)\n", hist, i, err)
+	}
+	if err != nil {
+		if !errors.IsErrUnknownKind(err, errors.KIND_ACCESSMETHOD) {
+			return errors.Wrapf(err, "%s: transferring source %d", hist, i)
 		}
+		printer.Printf("WARN: %s: transferring source %d: %s (enforce transport by reference)\n", hist, i, err)
 	}
 	return nil
 }
 
-func printArtefactInfo(printer common.Printer, kind string, index int, hint string) {
+func printArtefactInfo(printer common.Printer, kind string, index int, hint string, msg ...string) {
 	if printer != nil {
+		info := ""
+		if len(msg) > 0 {
+			info = " " + msg[0]
+		}
 		if hint != "" {
-			printer.Printf("...resource %d(%s)...\n", index, hint)
+			printer.Printf("...resource %d(%s)%s...\n", index, hint, info)
 		} else {
-			printer.Printf("...resource %d...\n", index)
+			printer.Printf("...resource %d%s...\n", index, info)
 		}
 	}
 }
@@ -15,10 +15,16 @@
 package standard
 
 import (
+	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/errors"
 )
 
 type Handler struct {
@@ -41,6 +47,14 @@ func New(opts ...transferhandler.TransferOption) (transferhandler.TransferHandle
 	return NewDefaultHandler(defaultOpts), nil
 }
 
+func (h *Handler) GetConcurrency() int {
+	return h.opts.GetConcurrency()
+}
+
+func (h *Handler) GetJournal() *journal.Journal {
+	return h.opts.GetJournal()
+}
+
 func (h *Handler) OverwriteVersion(src ocm.ComponentVersionAccess, tgt ocm.ComponentVersionAccess) (bool, error) {
 	return h.opts.IsOverwrite(), nil
 }
@@ -63,7 +77,25 @@ func (h *Handler) TransferSource(src ocm.ComponentVersionAccess, a ocm.AccessSpe
 }
 
 func (h *Handler) HandleTransferResource(r ocm.ResourceAccess, m ocm.AccessMethod, hint string, t ocm.ComponentVersionAccess) error {
-	return t.SetResourceBlob(r.Meta(), accessio.BlobAccessForDataAccess("", -1, m.MimeType(), m), hint, nil)
+	blob := accessio.BlobAccessForDataAccess("", -1, m.MimeType(), m)
+	meta := r.Meta()
+	if len(h.opts.GetPlatforms()) > 0 && meta.GetType() == resourcetypes.OCI_IMAGE && artdesc.IsOCIMediaType(m.MimeType()) {
+		if len(t.GetDescriptor().Signatures) > 0 && !h.opts.IsFilterSigned() {
+			return errors.Newf("resource %s: platform filtering would invalidate the signatures of signed component version %s", meta.GetName(), common.VersionedElementKey(t))
+		}
+		filtered, err := artefactset.FilterArtefactBlobForPlatforms(blob, h.opts.GetPlatforms())
+		if err != nil {
+			return errors.Wrapf(err, "resource %s", meta.GetName())
+		}
+		if filtered != nil {
+			defer filtered.Close()
+			blob = filtered
+			// the digest of the reduced image differs from the original one
+			meta = meta.Copy()
+			meta.Digest = nil
+		}
+	}
+	return t.SetResourceBlob(meta, blob, hint, nil)
 }
 
 func (h *Handler) HandleTransferSource(r ocm.SourceAccess, m ocm.AccessMethod, hint string, t ocm.ComponentVersionAccess) error {
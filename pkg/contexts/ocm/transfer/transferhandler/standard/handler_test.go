@@ -31,8 +31,12 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartefact"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	storagecontext "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/oci/ocirepo"
 	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/genericocireg"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
 	ocmsign "github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
@@ -44,13 +48,16 @@ import (
 
 const ARCH = "/tmp/ctf"
 const ARCH2 = "/tmp/ctf2"
+const ARCH3 = "/tmp/ctf3"
 const PROVIDER = "mandelsoft"
 const VERSION = "v1"
 const COMPONENT = "github.com/mandelsoft/test"
 const COMPONENT2 = "github.com/mandelsoft/test2"
 const OUT = "/tmp/res"
+const OUT2 = "/tmp/res2"
 const OCIPATH = "/tmp/oci"
 const OCINAMESPACE = "oci/test"
+const OCINAMESPACE2 = "oci/referred"
 const OCIVERSION = "v2.0"
 const OCIHOST = "alias"
 const SIGNATURE = "test"
@@ -178,6 +185,33 @@ var _ = Describe("Transfer handler", func() {
 		Expect(err).To(Succeed())
 	})
 
+	It("it should transfer resources and references in parallel", func() {
+		parentSrc, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH2, 0, env)
+		Expect(err).To(Succeed())
+		cv, err := parentSrc.LookupComponentVersion(COMPONENT2, VERSION)
+		Expect(err).To(Succeed())
+		childSrc, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
+		Expect(err).To(Succeed())
+		tgt, err := ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0700, accessio.FormatDirectory, env)
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+		handler, err := standard.New(standard.Recursive(), standard.ResourcesByValue(), standard.Resolver(childSrc), standard.Concurrency(4))
+		Expect(err).To(Succeed())
+		err = transfer.TransferVersion(nil, nil, cv, tgt, handler)
+		Expect(err).To(Succeed())
+
+		list, err := tgt.ComponentLister().GetComponents("", true)
+		Expect(err).To(Succeed())
+		Expect(list).To(ContainElements([]string{COMPONENT2, COMPONENT}))
+		comp, err := tgt.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		Expect(len(comp.GetDescriptor().Resources)).To(Equal(2))
+		Expect(comp.GetDescriptor().Resources[0].Name).To(Equal("testdata"))
+		data, err := json.Marshal(comp.GetDescriptor().Resources[1].Access)
+		Expect(err).To(Succeed())
+		Expect(string(data)).To(Equal("{\"localReference\":\"sha256:018520b2b249464a83e370619f544957b7936dd974468a128545eab88a0f53ed\",\"mediaType\":\"application/vnd.oci.image.manifest.v1+tar+gzip\",\"referenceName\":\"" + OCINAMESPACE + ":" + OCIVERSION + "\",\"type\":\"localBlob\"}"))
+	})
+
 	It("it should copy signatures", func() {
 		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env)
 		Expect(err).To(Succeed())
@@ -220,4 +254,243 @@ var _ = Describe("Transfer handler", func() {
 		Expect(err).To(Succeed())
 		Expect(dig.Value).To(Equal(digest))
 	})
+
+	It("it should copy a multi-arch image restricted to a platform", func() {
+		var arm64 *artdesc.Descriptor
+
+		env.OCICommonTransport(OCIPATH, accessio.FormatDirectory, func() {
+			env.Namespace(OCINAMESPACE, func() {
+				env.Index("multi", func() {
+					env.Manifest("", func() {
+						env.Platform("linux", "amd64")
+						env.Config(func() {
+							env.BlobStringData(mime.MIME_JSON, "{}")
+						})
+						env.Layer(func() {
+							env.BlobStringData(mime.MIME_TEXT, "amd64")
+						})
+					})
+					arm64 = env.Manifest("", func() {
+						env.Platform("linux", "arm64")
+						env.Config(func() {
+							env.BlobStringData(mime.MIME_JSON, "{}")
+						})
+						env.Layer(func() {
+							env.BlobStringData(mime.MIME_TEXT, "arm64")
+						})
+					})
+				})
+			})
+		})
+		env.OCMCommonTransport(ARCH3, accessio.FormatDirectory, func() {
+			env.Component(COMPONENT, func() {
+				env.Version(VERSION, func() {
+					env.Provider(PROVIDER)
+					env.Resource("image", "", resourcetypes.OCI_IMAGE, metav1.LocalRelation, func() {
+						env.Access(
+							ociartefact.New(oci.StandardOCIRef(OCIHOST+".alias", OCINAMESPACE, "multi")),
+						)
+					})
+				})
+			})
+		})
+
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH3, 0, env)
+		Expect(err).To(Succeed())
+		cv, err := src.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		tgt, err := ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0700, accessio.FormatDirectory, env)
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+		handler, err := standard.New(standard.ResourcesByValue(), standard.Platforms(&artdesc.Platform{OS: "linux", Architecture: "arm64"}))
+		Expect(err).To(Succeed())
+		err = transfer.TransferVersion(nil, nil, cv, tgt, handler)
+		Expect(err).To(Succeed())
+
+		comp, err := tgt.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		r, err := comp.GetResourceByIndex(0)
+		Expect(err).To(Succeed())
+		meth, err := r.AccessMethod()
+		Expect(err).To(Succeed())
+		defer meth.Close()
+		Expect(meth.MimeType()).To(Equal(artdesc.ToContentMediaType(artdesc.MediaTypeImageManifest) + "+tar+gzip"))
+		reader, err := meth.Reader()
+		Expect(err).To(Succeed())
+		defer reader.Close()
+		set, err := artefactset.Open(accessobj.ACC_READONLY, "", 0, accessio.Reader(reader))
+		Expect(err).To(Succeed())
+		defer set.Close()
+		Expect(set.GetMain()).To(Equal(arm64.Digest))
+	})
+	It("it should refuse platform filtering for signed component versions", func() {
+		env.OCICommonTransport(OCIPATH, accessio.FormatDirectory, func() {
+			env.Namespace(OCINAMESPACE, func() {
+				env.Index("multi", func() {
+					env.Manifest("", func() {
+						env.Platform("linux", "amd64")
+						env.Config(func() {
+							env.BlobStringData(mime.MIME_JSON, "{}")
+						})
+						env.Layer(func() {
+							env.BlobStringData(mime.MIME_TEXT, "amd64")
+						})
+					})
+					env.Manifest("", func() {
+						env.Platform("linux", "arm64")
+						env.Config(func() {
+							env.BlobStringData(mime.MIME_JSON, "{}")
+						})
+						env.Layer(func() {
+							env.BlobStringData(mime.MIME_TEXT, "arm64")
+						})
+					})
+				})
+			})
+		})
+		env.OCMCommonTransport(ARCH3, accessio.FormatDirectory, func() {
+			env.Component(COMPONENT, func() {
+				env.Version(VERSION, func() {
+					env.Provider(PROVIDER)
+					env.Resource("image", "", resourcetypes.OCI_IMAGE, metav1.LocalRelation, func() {
+						env.Access(
+							ociartefact.New(oci.StandardOCIRef(OCIHOST+".alias", OCINAMESPACE, "multi")),
+						)
+					})
+				})
+			})
+		})
+
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, ARCH3, 0, env)
+		Expect(err).To(Succeed())
+		defer src.Close()
+		cv, err := src.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer cv.Close()
+
+		opts := ocmsign.NewOptions(
+			ocmsign.Sign(signing.DefaultHandlerRegistry().GetSigner(SIGN_ALGO), SIGNATURE),
+			ocmsign.Resolver(ocm.NewCompoundResolver(src)),
+			ocmsign.Update(), ocmsign.VerifyDigests(),
+		)
+		Expect(opts.Complete(signingattr.Get(env.OCMContext()))).To(Succeed())
+		_, err = ocmsign.Apply(nil, nil, cv, opts)
+		Expect(err).To(Succeed())
+
+		tgt, err := ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0700, accessio.FormatDirectory, env)
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+
+		platform := &artdesc.Platform{OS: "linux", Architecture: "arm64"}
+		handler, err := standard.New(standard.ResourcesByValue(), standard.Platforms(platform))
+		Expect(err).To(Succeed())
+		err = transfer.TransferVersion(nil, nil, cv, tgt, handler)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("resource image: platform filtering would invalidate the signatures of signed component version " + COMPONENT + ":" + VERSION))
+
+		tgt, err = ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT2, 0700, accessio.FormatDirectory, env)
+		Expect(err).To(Succeed())
+		defer tgt.Close()
+		handler, err = standard.New(standard.ResourcesByValue(), standard.Platforms(platform), standard.FilterSigned())
+		Expect(err).To(Succeed())
+		err = transfer.TransferVersion(nil, nil, cv, tgt, handler)
+		Expect(err).To(Succeed())
+
+		comp, err := tgt.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer comp.Close()
+		Expect(len(comp.GetDescriptor().Signatures)).To(Equal(1))
+		Expect(comp.GetDescriptor().Resources[0].Digest).NotTo(Equal(cv.GetDescriptor().Resources[0].Digest))
+
+		opts = ocmsign.NewOptions(
+			ocmsign.Resolver(ocm.NewCompoundResolver(tgt)),
+			ocmsign.VerifySignature(SIGNATURE),
+			ocmsign.VerifyDigests(),
+		)
+		Expect(opts.Complete(signingattr.Get(env.OCMContext()))).To(Succeed())
+		dig, err := ocmsign.Apply(nil, nil, comp, opts)
+		Expect(err).To(Succeed())
+		// the signed digest does not match the filtered component version anymore
+		Expect(dig.Value).NotTo(Equal(comp.GetDescriptor().Signatures[0].Digest.Value))
+	})
+	It("it should copy referrers of oci artefacts by value", func() {
+		var subject, referrer *artdesc.Descriptor
+
+		env.OCICommonTransport(OCIPATH, accessio.FormatDirectory, func() {
+			env.Namespace(OCINAMESPACE2, func() {
+				subject = env.Manifest(OCIVERSION, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "subject")
+					})
+				})
+				referrer = env.Manifest("", func() {
+					env.ArtifactType("application/vnd.example.sbom")
+					env.Subject(subject)
+					env.Config(func() {
+						env.BlobStringData(artdesc.MediaTypeEmptyJSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_JSON, "{\"sbom\":\"test\"}")
+					})
+				})
+			})
+		})
+		env.OCMCommonTransport(ARCH3, accessio.FormatDirectory, func() {
+			env.Component(COMPONENT, func() {
+				env.Version(VERSION, func() {
+					env.Provider(PROVIDER)
+					env.Resource("image", "", resourcetypes.OCI_IMAGE, metav1.LocalRelation, func() {
+						env.Access(
+							ociartefact.New(oci.StandardOCIRef(OCIHOST+".alias", OCINAMESPACE2, OCIVERSION)),
+						)
+					})
+				})
+			})
+		})
+
+		src, err := ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH3, 0, env)
+		Expect(err).To(Succeed())
+		defer src.Close()
+		cv, err := src.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		defer cv.Close()
+
+		// target OCM repository storing oci artefacts as oci artefacts
+		amime := artdesc.ToContentMediaType(artdesc.MediaTypeImageManifest) + "+tar+gzip"
+		base := func(*storagecontext.StorageContext) string { return "target" }
+		ctx := ocm.WithOCIRepositories(env.OCIContext()).WithBlobHandlers(ocm.DefaultBlobHandlers().Copy().Register(ocirepo.NewArtefactHandler(base), cpi.ForMimeType(amime))).New()
+		ocispec := ctfoci.NewRepositorySpec(accessobj.ACC_CREATE, OUT, accessio.PathFileSystem(env.FileSystem()), accessio.FormatDirectory)
+		tgt, err := ctx.RepositoryForSpec(genericocireg.NewRepositorySpec(ocispec, nil))
+		Expect(err).To(Succeed())
+
+		handler, err := standard.New(standard.ResourcesByValue())
+		Expect(err).To(Succeed())
+		err = transfer.TransferVersion(nil, nil, cv, tgt, handler)
+		Expect(err).To(Succeed())
+
+		comp, err := tgt.LookupComponentVersion(COMPONENT, VERSION)
+		Expect(err).To(Succeed())
+		r, err := comp.GetResourceByIndex(0)
+		Expect(err).To(Succeed())
+		acc, err := r.Access()
+		Expect(err).To(Succeed())
+		Expect(acc.GetKind()).To(Equal(ociartefact.Type))
+		Expect(comp.Close()).To(Succeed())
+		Expect(tgt.Close()).To(Succeed())
+
+		tgtoci, err := env.OCIContext().RepositoryForSpec(ctfoci.NewRepositorySpec(accessobj.ACC_READONLY, OUT, accessio.PathFileSystem(env.FileSystem())))
+		Expect(err).To(Succeed())
+		defer tgtoci.Close()
+		ns, err := tgtoci.LookupNamespace(OCINAMESPACE2)
+		Expect(err).To(Succeed())
+		defer ns.Close()
+		list, err := ns.GetReferrers(subject.Digest, "")
+		Expect(err).To(Succeed())
+		Expect(len(list)).To(Equal(1))
+		Expect(list[0].Digest).To(Equal(referrer.Digest))
+		Expect(list[0].ArtifactType).To(Equal("application/vnd.example.sbom"))
+	})
 })
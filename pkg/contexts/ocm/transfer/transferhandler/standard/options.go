@@ -15,7 +15,9 @@
 package standard
 
 import (
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
 	"github.com/open-component-model/ocm/pkg/errors"
 )
@@ -25,7 +27,11 @@ type Options struct {
 	resourcesByValue bool
 	sourcesByValue   bool
 	overwrite        bool
+	concurrency      int
+	journal          *journal.Journal
 	resolver         ocm.ComponentVersionResolver
+	platforms        []*artdesc.Platform
+	filterSigned     bool
 }
 
 var (
@@ -33,6 +39,10 @@ var (
 	_ SourcesByValueOption   = (*Options)(nil)
 	_ RecursiveOption        = (*Options)(nil)
 	_ ResolverOption         = (*Options)(nil)
+	_ ConcurrencyOption      = (*Options)(nil)
+	_ JournalOption          = (*Options)(nil)
+	_ PlatformsOption        = (*Options)(nil)
+	_ FilterSignedOption     = (*Options)(nil)
 )
 
 func (o *Options) SetOverwrite(overwrite bool) {
@@ -51,10 +61,26 @@ func (o *Options) SetSourcesByValue(sourcesByValue bool) {
 	o.sourcesByValue = sourcesByValue
 }
 
+func (o *Options) SetConcurrency(concurrency int) {
+	o.concurrency = concurrency
+}
+
+func (o *Options) SetJournal(journal *journal.Journal) {
+	o.journal = journal
+}
+
 func (o *Options) SetResolver(resolver ocm.ComponentVersionResolver) {
 	o.resolver = resolver
 }
 
+func (o *Options) SetPlatforms(platforms []*artdesc.Platform) {
+	o.platforms = platforms
+}
+
+func (o *Options) SetFilterSigned(filterSigned bool) {
+	o.filterSigned = filterSigned
+}
+
 func (o *Options) IsOverwrite() bool {
 	return o.overwrite
 }
@@ -71,10 +97,26 @@ func (o *Options) IsSourcesByValue() bool {
 	return o.sourcesByValue
 }
 
+func (o *Options) GetConcurrency() int {
+	return o.concurrency
+}
+
+func (o *Options) GetJournal() *journal.Journal {
+	return o.journal
+}
+
 func (o *Options) GetResolver() ocm.ComponentVersionResolver {
 	return o.resolver
 }
 
+func (o *Options) GetPlatforms() []*artdesc.Platform {
+	return o.platforms
+}
+
+func (o *Options) IsFilterSigned() bool {
+	return o.filterSigned
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 
 func GetFlag(args ...bool) bool {
@@ -217,3 +259,119 @@ func Resolver(resolver ocm.ComponentVersionResolver) transferhandler.TransferOpt
 		resolver: resolver,
 	}
 }
+
+///////////////////////////////////////////////////////////////////////////////
+
+// ConcurrencyOption describes the maximum number of blobs
+// transferred in parallel. Values less than two
+// result in a sequential transfer.
+type ConcurrencyOption interface {
+	SetConcurrency(int)
+	GetConcurrency() int
+}
+
+type concurrencyOption struct {
+	concurrency int
+}
+
+func (o *concurrencyOption) ApplyTransferOption(to transferhandler.TransferOptions) error {
+	if eff, ok := to.(ConcurrencyOption); ok {
+		eff.SetConcurrency(o.concurrency)
+		return nil
+	} else {
+		return errors.ErrNotSupported("concurrency")
+	}
+}
+
+func Concurrency(n int) transferhandler.TransferOption {
+	return &concurrencyOption{
+		concurrency: n,
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+// JournalOption describes the journal used to record the
+// progress of a transfer.
+type JournalOption interface {
+	SetJournal(*journal.Journal)
+	GetJournal() *journal.Journal
+}
+
+type journalOption struct {
+	journal *journal.Journal
+}
+
+func (o *journalOption) ApplyTransferOption(to transferhandler.TransferOptions) error {
+	if eff, ok := to.(JournalOption); ok {
+		eff.SetJournal(o.journal)
+		return nil
+	} else {
+		return errors.ErrNotSupported("journal")
+	}
+}
+
+func Journal(j *journal.Journal) transferhandler.TransferOption {
+	return &journalOption{
+		journal: j,
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+// PlatformsOption restricts the transfer of multi-arch images
+// for resources of type ociImage to the given platforms.
+// It is only used for resources transferred by value.
+type PlatformsOption interface {
+	SetPlatforms([]*artdesc.Platform)
+	GetPlatforms() []*artdesc.Platform
+}
+
+type platformsOption struct {
+	platforms []*artdesc.Platform
+}
+
+func (o *platformsOption) ApplyTransferOption(to transferhandler.TransferOptions) error {
+	if eff, ok := to.(PlatformsOption); ok {
+		eff.SetPlatforms(o.platforms)
+		return nil
+	} else {
+		return errors.ErrNotSupported("platforms")
+	}
+}
+
+func Platforms(platforms ...*artdesc.Platform) transferhandler.TransferOption {
+	return &platformsOption{
+		platforms: platforms,
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+// FilterSignedOption permits the platform filtering for signed
+// component versions. The filtering changes the digests of the
+// affected resources, therefore the signatures of such component
+// versions become invalid and have to be recreated after the transfer.
+type FilterSignedOption interface {
+	SetFilterSigned(bool)
+	IsFilterSigned() bool
+}
+
+type filterSignedOption struct {
+	filterSigned bool
+}
+
+func (o *filterSignedOption) ApplyTransferOption(to transferhandler.TransferOptions) error {
+	if eff, ok := to.(FilterSignedOption); ok {
+		eff.SetFilterSigned(o.filterSigned)
+		return nil
+	} else {
+		return errors.ErrNotSupported("filter signed")
+	}
+}
+
+func FilterSigned(args ...bool) transferhandler.TransferOption {
+	return &filterSignedOption{
+		filterSigned: GetFlag(args...),
+	}
+}
@@ -17,6 +17,7 @@ package transferhandler
 import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
 	"github.com/open-component-model/ocm/pkg/errors"
 )
 
@@ -37,6 +38,18 @@ type TransferHandler interface {
 	HandleTransferSource(r ocm.SourceAccess, m ocm.AccessMethod, hint string, t ocm.ComponentVersionAccess) error
 }
 
+// ConcurrencyHandler is an optional interface of a TransferHandler
+// providing the maximum number of blobs transferred in parallel.
+type ConcurrencyHandler interface {
+	GetConcurrency() int
+}
+
+// JournalHandler is an optional interface of a TransferHandler
+// providing a journal to record the progress of a transfer.
+type JournalHandler interface {
+	GetJournal() *journal.Journal
+}
+
 func ApplyOptions(set TransferOptions, opts ...TransferOption) error {
 	list := errors.ErrListf("transfer options")
 	for _, o := range opts {
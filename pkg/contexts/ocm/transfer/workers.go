@@ -0,0 +1,99 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package transfer
+
+import (
+	"sync"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+)
+
+// workers describes the parallelism of a transfer.
+// It limits the number of blobs transferred in parallel
+// and synchronizes the access to the shared walking state.
+// A nil workers object describes a sequential transfer.
+type workers struct {
+	lock  sync.Mutex
+	slots chan struct{}
+}
+
+func newWorkers(handler transferhandler.TransferHandler) *workers {
+	if h, ok := handler.(transferhandler.ConcurrencyHandler); ok {
+		if n := h.GetConcurrency(); n > 1 {
+			return &workers{slots: make(chan struct{}, n)}
+		}
+	}
+	return nil
+}
+
+func (w *workers) Add(state *common.WalkingState, kind string, nv common.NameVersion) (bool, error) {
+	if w != nil {
+		w.lock.Lock()
+		defer w.lock.Unlock()
+	}
+	return state.Add(kind, nv)
+}
+
+// NewGroup provides a new group of jobs. If limited is set,
+// the jobs are executed under control of the worker slots.
+// Jobs waiting for other jobs must not be limited,
+// otherwise nested transfers could block each other.
+func (w *workers) NewGroup(limited bool) *group {
+	return &group{workers: w, limited: limited}
+}
+
+// group executes jobs and keeps their errors in the
+// order the jobs have been started.
+type group struct {
+	workers *workers
+	limited bool
+	wg      sync.WaitGroup
+	errs    []*error
+}
+
+// Run executes the given job. For a sequential transfer the job
+// is executed synchronously and its error is returned. Otherwise,
+// the job is executed asynchronously and its error is only
+// reported by Wait.
+func (g *group) Run(job func() error) error {
+	result := new(error)
+	g.errs = append(g.errs, result)
+	if g.workers == nil {
+		*result = job()
+		return *result
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.limited {
+			g.workers.slots <- struct{}{}
+			defer func() { <-g.workers.slots }()
+		}
+		*result = job()
+	}()
+	return nil
+}
+
+// Wait waits for all started jobs and returns their errors
+// in the order of their start.
+func (g *group) Wait() []error {
+	g.wg.Wait()
+	errs := make([]error, len(g.errs))
+	for i, e := range g.errs {
+		errs[i] = *e
+	}
+	return errs
+}
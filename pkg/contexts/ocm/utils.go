@@ -22,8 +22,11 @@ import (
 
 	"github.com/open-component-model/ocm/pkg/common/accessio"
 	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	ocictf "github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/comparch"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/genericocireg"
 	"github.com/open-component-model/ocm/pkg/errors"
 )
 
@@ -62,7 +65,14 @@ func AssureTargetRepository(session Session, ctx Context, targetref string, opts
 	}
 	ref.TypeHint = archive
 	ref.CreateIfMissing = true
-	target, err := session.DetermineRepositoryBySpec(ctx, &ref)
+	spec, err := ctx.MapUniformRepositorySpec(&ref)
+	var target Repository
+	if err == nil {
+		if fs != nil {
+			setPathFileSystem(spec, fs)
+		}
+		target, err = session.LookupRepository(ctx, spec)
+	}
 	if err != nil {
 		if !errors.IsErrUnknown(err) || vfs.IsErrNotExist(err) || ref.Info == "" {
 			return nil, err
@@ -82,6 +92,19 @@ func AssureTargetRepository(session Session, ctx Context, targetref string, opts
 	return target, nil
 }
 
+// setPathFileSystem sets the filesystem used to evaluate the path
+// of file based repository specifications.
+func setPathFileSystem(spec RepositorySpec, fs vfs.FileSystem) {
+	switch s := spec.(type) {
+	case *comparch.RepositorySpec:
+		s.PathFileSystem = fs
+	case *genericocireg.RepositorySpec:
+		if c, ok := s.RepositorySpec.(*ocictf.RepositorySpec); ok {
+			c.PathFileSystem = fs
+		}
+	}
+}
+
 type AccessMethodSource = cpi.AccessMethodSource
 
 // ResourceReader gets a Reader for a given resource/source access.
@@ -0,0 +1,242 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package validate
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/none"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/utils"
+)
+
+const (
+	SEVERITY_ERROR   = "error"
+	SEVERITY_WARNING = "warning"
+	SEVERITY_INFO    = "info"
+)
+
+const (
+	KIND_COMPONENT = "component"
+	KIND_RESOURCE  = "resource"
+	KIND_SOURCE    = "source"
+	KIND_REFERENCE = "reference"
+)
+
+// Finding describes a single problem found for a component version.
+type Finding struct {
+	Severity string          `json:"severity"`
+	Kind     string          `json:"kind"`
+	Identity metav1.Identity `json:"identity,omitempty"`
+	Message  string          `json:"message"`
+}
+
+// Findings is a list of validation findings.
+type Findings []Finding
+
+// Count returns the number of findings with the given severity.
+func (f Findings) Count(severity string) int {
+	n := 0
+	for _, e := range f {
+		if e.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
+
+// HasErrors returns whether there are findings of severity error.
+func (f Findings) HasErrors() bool {
+	return f.Count(SEVERITY_ERROR) > 0
+}
+
+type validator struct {
+	findings Findings
+}
+
+var kindOrder = map[string]int{
+	KIND_COMPONENT: 0,
+	KIND_RESOURCE:  1,
+	KIND_SOURCE:    2,
+	KIND_REFERENCE: 3,
+}
+
+// result returns the findings ordered by element.
+func (v *validator) result() Findings {
+	sort.SliceStable(v.findings, func(i, j int) bool {
+		a, b := v.findings[i], v.findings[j]
+		if a.Kind != b.Kind {
+			return kindOrder[a.Kind] < kindOrder[b.Kind]
+		}
+		return a.Identity.String() < b.Identity.String()
+	})
+	return v.findings
+}
+
+func (v *validator) add(severity, kind string, id metav1.Identity, msg string, args ...interface{}) {
+	v.findings = append(v.findings, Finding{
+		Severity: severity,
+		Kind:     kind,
+		Identity: id,
+		Message:  fmt.Sprintf(msg, args...),
+	})
+}
+
+// Descriptor checks a component descriptor for semantic problems
+// not covered by the schema validation, which are non-unique element
+// identities, unresolvable source references of resources, missing digests
+// of resources and component references and labels not covered by signatures.
+func Descriptor(cd *compdesc.ComponentDescriptor) Findings {
+	v := &validator{}
+	v.descriptor(cd)
+	return v.result()
+}
+
+// ComponentVersion checks a component version. Additionally to the
+// descriptor checks, it checks the resolvability of component references
+// (using the repository of the component version and the optional resolver),
+// the consistency of the relation and the access specifications of resources
+// and the existence of blobs for local access specifications.
+func ComponentVersion(cv ocm.ComponentVersionAccess, resolver ocm.ComponentVersionResolver) Findings {
+	v := &validator{}
+	cd := cv.GetDescriptor()
+	v.descriptor(cd)
+
+	for _, r := range cd.References {
+		id := r.GetIdentity(cd.References)
+		nested, err := utils.ResolveReferencePath(cv, []metav1.Identity{id}, resolver)
+		if err != nil {
+			v.add(SEVERITY_WARNING, KIND_REFERENCE, id, "%s", err)
+			continue
+		}
+		nested.Close()
+	}
+	for i, r := range cv.GetResources() {
+		id := cd.Resources[i].GetIdentity(cd.Resources)
+		local := v.access(cv, KIND_RESOURCE, id, r)
+		if r.Meta().Relation == metav1.LocalRelation && local != nil && !*local {
+			v.add(SEVERITY_WARNING, KIND_RESOURCE, id, "local resource uses non-local access")
+		}
+	}
+	for i, s := range cv.GetSources() {
+		v.access(cv, KIND_SOURCE, cd.Sources[i].GetIdentity(cd.Sources), s)
+	}
+	return v.result()
+}
+
+type baseAccess interface {
+	Access() (ocm.AccessSpec, error)
+	AccessMethod() (ocm.AccessMethod, error)
+}
+
+// access checks the access specification of an element. For local
+// access specifications the existence of the blob is checked.
+// It returns whether the access is local or nil if the access
+// specification is invalid.
+func (v *validator) access(cv ocm.ComponentVersionAccess, kind string, id metav1.Identity, acc baseAccess) *bool {
+	spec, err := acc.Access()
+	if err != nil {
+		v.add(SEVERITY_ERROR, kind, id, "invalid access specification: %s", err)
+		return nil
+	}
+	local := spec.IsLocal(cv.GetContext())
+	if local {
+		m, err := acc.AccessMethod()
+		if err == nil {
+			var r io.ReadCloser
+			r, err = m.Reader()
+			if err == nil {
+				// readers may be opened lazily, so enforce an access
+				_, err = r.Read(make([]byte, 1))
+				if err == io.EOF {
+					err = nil
+				}
+				r.Close()
+			}
+			m.Close()
+		}
+		if err != nil {
+			v.add(SEVERITY_ERROR, kind, id, "local blob not accessible: %s", err)
+		}
+	}
+	return &local
+}
+
+func (v *validator) descriptor(cd *compdesc.ComponentDescriptor) {
+	v.labels(KIND_COMPONENT, nil, cd.Labels)
+
+	v.identities(KIND_RESOURCE, cd.Resources)
+	v.identities(KIND_SOURCE, cd.Sources)
+	v.identities(KIND_REFERENCE, cd.References)
+
+	for _, r := range cd.Resources {
+		id := r.GetIdentity(cd.Resources)
+		v.labels(KIND_RESOURCE, id, r.Labels)
+		if r.Digest == nil && (r.Access == nil || r.Access.GetKind() != none.Type) {
+			v.add(SEVERITY_WARNING, KIND_RESOURCE, id, "no digest")
+		}
+		for j, ref := range r.SourceRef {
+			if !matchSource(cd.Sources, ref) {
+				v.add(SEVERITY_ERROR, KIND_RESOURCE, id, "source reference %d (%s) does not match any source", j+1, metav1.Identity(ref.IdentitySelector))
+			}
+		}
+		if r.Access == nil {
+			v.add(SEVERITY_ERROR, KIND_RESOURCE, id, "no access specification")
+		}
+	}
+	for _, s := range cd.Sources {
+		v.labels(KIND_SOURCE, s.GetIdentity(cd.Sources), s.Labels)
+	}
+	for _, r := range cd.References {
+		id := r.GetIdentity(cd.References)
+		v.labels(KIND_REFERENCE, id, r.Labels)
+		if r.Digest == nil {
+			v.add(SEVERITY_WARNING, KIND_REFERENCE, id, "no digest")
+		}
+	}
+}
+
+func (v *validator) identities(kind string, list compdesc.ElementAccessor) {
+	for i := 0; i < list.Len(); i++ {
+		id := list.Get(i).GetMeta().GetIdentity(list)
+		for j := 0; j < i; j++ {
+			if list.Get(j).GetMeta().GetIdentity(list).Equals(id) {
+				v.add(SEVERITY_ERROR, kind, id, "duplicate identity (entries %d and %d)", j+1, i+1)
+				break
+			}
+		}
+	}
+}
+
+func (v *validator) labels(kind string, id metav1.Identity, labels metav1.Labels) {
+	for _, l := range labels {
+		if !l.Signing {
+			v.add(SEVERITY_INFO, kind, id, "label %q not covered by signatures", l.Name)
+		}
+	}
+}
+
+func matchSource(sources compdesc.Sources, ref compdesc.SourceRef) bool {
+	for _, s := range sources {
+		if ok, _ := metav1.Identity(ref.IdentitySelector).Match(s.GetMatchBaseIdentity()); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package validate_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartefact"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/utils/validate"
+)
+
+var _ = Describe("validation", func() {
+	var cd *compdesc.ComponentDescriptor
+
+	digest := &metav1.DigestSpec{
+		HashAlgorithm:          "sha256",
+		NormalisationAlgorithm: "genericBlobDigest/v1",
+		Value:                  "0815",
+	}
+
+	resource := func(name string, src ...string) compdesc.Resource {
+		r := compdesc.Resource{
+			ResourceMeta: compdesc.ResourceMeta{
+				ElementMeta: compdesc.ElementMeta{
+					Name:    name,
+					Version: "v1",
+				},
+				Type:     "ociImage",
+				Relation: metav1.ExternalRelation,
+				Digest:   digest,
+			},
+			Access: ociartefact.New("ghcr.io/acme/" + name + ":v1"),
+		}
+		for _, s := range src {
+			r.SourceRef = append(r.SourceRef, compdesc.SourceRef{IdentitySelector: metav1.StringMap{"name": s}})
+		}
+		return r
+	}
+
+	BeforeEach(func() {
+		cd = compdesc.New("acme.org/test", "v1")
+		cd.Sources = append(cd.Sources, compdesc.Source{
+			SourceMeta: compdesc.SourceMeta{
+				ElementMeta: compdesc.ElementMeta{
+					Name:    "src",
+					Version: "v1",
+				},
+				Type: "git",
+			},
+			Access: ociartefact.New("ghcr.io/acme/src:v1"),
+		})
+		cd.Resources = append(cd.Resources, resource("image", "src"))
+	})
+
+	It("accepts valid descriptor", func() {
+		Expect(validate.Descriptor(cd)).To(BeEmpty())
+	})
+
+	It("reports problems", func() {
+		cd.Resources = append(cd.Resources, resource("other", "unknown"))
+		cd.Resources[1].Digest = nil
+		Expect(cd.Resources[1].Labels.Set("purpose", "test")).To(Succeed())
+		findings := validate.Descriptor(cd)
+		Expect(findings).To(Equal(validate.Findings{
+			{Severity: validate.SEVERITY_INFO, Kind: validate.KIND_RESOURCE, Identity: metav1.NewIdentity("other"), Message: `label "purpose" not covered by signatures`},
+			{Severity: validate.SEVERITY_WARNING, Kind: validate.KIND_RESOURCE, Identity: metav1.NewIdentity("other"), Message: "no digest"},
+			{Severity: validate.SEVERITY_ERROR, Kind: validate.KIND_RESOURCE, Identity: metav1.NewIdentity("other"), Message: `source reference 1 ("name"="unknown") does not match any source`},
+		}))
+		Expect(findings.HasErrors()).To(BeTrue())
+	})
+
+	It("reports duplicate identities", func() {
+		cd.Resources = append(cd.Resources, resource("image", "src"))
+		id := metav1.NewIdentity("image", "version", "v1")
+		Expect(validate.Descriptor(cd)).To(Equal(validate.Findings{
+			{Severity: validate.SEVERITY_ERROR, Kind: validate.KIND_RESOURCE, Identity: id, Message: "duplicate identity (entries 1 and 2)"},
+		}))
+	})
+})
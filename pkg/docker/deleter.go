@@ -0,0 +1,113 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package docker
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/pkg/errors"
+
+	"github.com/open-component-model/ocm/pkg/docker/resolve"
+)
+
+var ErrObjectRequired = errors.New("object required")
+
+type dockerDeleter struct {
+	dockerBase *dockerBase
+}
+
+func (r *dockerResolver) Deleter(ctx context.Context, ref string) (resolve.Deleter, error) {
+	base, err := r.resolveDockerBase(ref)
+	if err != nil {
+		return nil, err
+	}
+	if base.refspec.Object == "" {
+		return nil, ErrObjectRequired
+	}
+
+	return &dockerDeleter{
+		dockerBase: base,
+	}, nil
+}
+
+// Delete deletes a manifest or tag using the distribution API.
+// Manifests are deleted by digest, tags by their name. The deletion of tags
+// is not supported by all registries.
+func (r *dockerDeleter) Delete(ctx context.Context) error {
+	refspec := r.dockerBase.refspec
+	base := r.dockerBase
+	var firstErr error
+
+	obj := refspec.Object
+	if dgst := refspec.Digest(); dgst != "" {
+		obj = dgst.String()
+	}
+
+	hosts := base.filterHosts(HostCapabilityPush)
+	if len(hosts) == 0 {
+		return errors.Wrap(errdefs.ErrNotFound, "no delete hosts")
+	}
+
+	scope, err := RepositoryScope(refspec, true)
+	if err != nil {
+		return err
+	}
+	ctx = WithScope(ctx, scope+",delete")
+
+	for _, host := range hosts {
+		ctxWithLogger := log.WithLogger(ctx, log.G(ctx).WithField("host", host.Host))
+
+		req := base.request(host, http.MethodDelete, "manifests", obj)
+		if err := req.addNamespace(base.refspec.Hostname()); err != nil {
+			return err
+		}
+
+		log.G(ctxWithLogger).Debug("deleting")
+		resp, err := req.doWithRetries(ctxWithLogger, nil)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			log.G(ctxWithLogger).WithError(err).Info("trying next host")
+			continue // try another host
+		}
+		resp.Body.Close()
+
+		switch {
+		case resp.StatusCode <= 299:
+			return nil
+		case resp.StatusCode == http.StatusNotFound:
+			if firstErr == nil {
+				firstErr = errors.Wrapf(errdefs.ErrNotFound, "%s", refspec)
+			}
+		case resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusBadRequest:
+			if firstErr == nil {
+				firstErr = errors.Wrapf(errdefs.ErrNotImplemented, "delete of %s on host %s: %s", obj, host.Host, resp.Status)
+			}
+		default:
+			if firstErr == nil {
+				firstErr = errors.Errorf("delete of %s on host %s failed with status code %v", obj, host.Host, resp.Status)
+			}
+		}
+	}
+
+	if firstErr == nil {
+		firstErr = errors.Wrap(errdefs.ErrNotFound, base.refspec.Locator)
+	}
+	return firstErr
+}
@@ -20,6 +20,8 @@ import (
 
 var (
 	ContextWithRepositoryScope           = docker.ContextWithRepositoryScope
+	RepositoryScope                      = docker.RepositoryScope
+	WithScope                            = docker.WithScope
 	ContextWithAppendPullRepositoryScope = docker.ContextWithAppendPullRepositoryScope
 	NewInMemoryTracker                   = docker.NewInMemoryTracker
 	NewDockerAuthorizer                  = docker.NewDockerAuthorizer
@@ -0,0 +1,121 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package docker
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/pkg/errors"
+
+	"github.com/open-component-model/ocm/pkg/docker/resolve"
+)
+
+var ErrDigestRequired = errors.New("digest required")
+
+type dockerReferrers struct {
+	dockerBase *dockerBase
+}
+
+func (r *dockerResolver) Referrers(ctx context.Context, ref string) (resolve.Referrers, error) {
+	base, err := r.resolveDockerBase(ref)
+	if err != nil {
+		return nil, err
+	}
+	if base.refspec.Digest() == "" {
+		return nil, ErrDigestRequired
+	}
+
+	return &dockerReferrers{
+		dockerBase: base,
+	}, nil
+}
+
+// GetReferrers uses the referrers API of the distribution spec.
+// Registries not supporting this API respond with status code 404.
+func (r *dockerReferrers) GetReferrers(ctx context.Context, artifactType string) ([]byte, error) {
+	refspec := r.dockerBase.refspec
+	base := r.dockerBase
+	var firstErr error
+
+	hosts := base.filterHosts(HostCapabilityPull | HostCapabilityResolve)
+	if len(hosts) == 0 {
+		return nil, errors.Wrap(errdefs.ErrNotFound, "no referrers hosts")
+	}
+
+	ctx, err := ContextWithRepositoryScope(ctx, refspec, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, host := range hosts {
+		ctxWithLogger := log.WithLogger(ctx, log.G(ctx).WithField("host", host.Host))
+
+		req := base.request(host, http.MethodGet, "referrers", refspec.Digest().String())
+		if err := req.addNamespace(base.refspec.Hostname()); err != nil {
+			return nil, err
+		}
+		if artifactType != "" {
+			sep := "?"
+			if strings.Contains(req.path, "?") {
+				sep = "&"
+			}
+			req.path += sep + "artifactType=" + url.QueryEscape(artifactType)
+		}
+		req.header["Accept"] = []string{"application/vnd.oci.image.index.v1+json"}
+
+		log.G(ctxWithLogger).Debug("listing referrers")
+		resp, err := req.doWithRetries(ctxWithLogger, nil)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			log.G(ctxWithLogger).WithError(err).Info("trying next host")
+			continue // try another host
+		}
+
+		if resp.StatusCode > 299 {
+			resp.Body.Close()
+			switch resp.StatusCode {
+			case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusBadRequest:
+				if firstErr == nil {
+					firstErr = errors.Wrapf(errdefs.ErrNotImplemented, "referrers API on host %s", host.Host)
+				}
+			default:
+				if firstErr == nil {
+					firstErr = errors.Errorf("referrers from host %s failed with status code %v", host.Host, resp.Status)
+				}
+			}
+			continue // try another host
+		}
+
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+
+	if firstErr == nil {
+		firstErr = errors.Wrap(errdefs.ErrNotFound, base.refspec.Locator)
+	}
+	return nil, firstErr
+}
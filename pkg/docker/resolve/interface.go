@@ -55,6 +55,14 @@ type Resolver interface {
 	Pusher(ctx context.Context, ref string) (Pusher, error)
 
 	Lister(ctx context.Context, ref string) (Lister, error)
+
+	// Deleter returns a new deleter for the provided reference.
+	// The reference must describe a tag or digest.
+	Deleter(ctx context.Context, ref string) (Deleter, error)
+
+	// Referrers returns a new referrers accessor for the provided reference.
+	// The reference must describe a digest.
+	Referrers(ctx context.Context, ref string) (Referrers, error)
 }
 
 // Fetcher fetches content.
@@ -75,6 +83,21 @@ type Lister interface {
 	List(context.Context) ([]string, error)
 }
 
+type Deleter interface {
+	// Delete deletes the manifest (for a digest) or the tag
+	// described by the reference used to create the deleter.
+	Delete(context.Context) error
+}
+
+type Referrers interface {
+	// GetReferrers returns the image index provided by the referrers API
+	// for the digest used to create the accessor. If an artifact type is given,
+	// the registry may filter the result accordingly.
+	// If the registry does not support the referrers API an error
+	// matching errdefs.ErrNotImplemented is returned.
+	GetReferrers(ctx context.Context, artifactType string) ([]byte, error)
+}
+
 // PushRequest handles the result of a push request
 // replaces containerd content.Writer.
 type PushRequest interface {
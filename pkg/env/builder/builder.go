@@ -20,6 +20,7 @@ import (
 
 	"github.com/open-component-model/ocm/pkg/common/accessio"
 	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
 	"github.com/open-component-model/ocm/pkg/env"
@@ -68,7 +69,8 @@ type Builder struct {
 	oci_artacc        oci.ArtefactAccess
 	oci_cleanuplayers bool
 	oci_tags          *[]string
-	oci_artfunc       func(oci.ArtefactAccess) error
+	oci_platform      **artdesc.Platform
+	oci_artfunc       func(oci.ArtefactAccess, *artdesc.Platform) error
 }
 
 func NewBuilder(t *env.Environment) *Builder {
@@ -96,6 +98,7 @@ func (b *Builder) set() {
 	b.oci_artacc = nil
 	b.oci_cleanuplayers = false
 	b.oci_tags = nil
+	b.oci_platform = nil
 	b.oci_artfunc = nil
 
 	if len(b.stack) > 0 {
@@ -30,10 +30,11 @@ const (
 type ociArtefact struct {
 	base
 	kind    string
-	artfunc func(a oci.ArtefactAccess) error
+	artfunc func(a oci.ArtefactAccess, platform *artdesc.Platform) error
 	ns      cpi.NamespaceAccess
 	cpi.ArtefactAccess
-	tags []string
+	tags     []string
+	platform *artdesc.Platform
 }
 
 func (r *ociArtefact) Type() string {
@@ -45,6 +46,7 @@ func (r *ociArtefact) Set() {
 	r.Builder.oci_artacc = r.ArtefactAccess
 	r.Builder.oci_cleanuplayers = true
 	r.Builder.oci_tags = &r.tags
+	r.Builder.oci_platform = &r.platform
 
 	if r.ns != nil {
 		r.Builder.oci_artfunc = r.addArtefact
@@ -58,7 +60,7 @@ func (r *ociArtefact) Close() error {
 	}
 	blob, err := r.Builder.oci_nsacc.AddArtefact(r.ArtefactAccess, r.tags...)
 	if err == nil && r.artfunc != nil {
-		err = r.artfunc(r.ArtefactAccess)
+		err = r.artfunc(r.ArtefactAccess, r.platform)
 	}
 	if err == nil {
 		r.result = artdesc.DefaultBlobDescriptor(blob)
@@ -66,8 +68,8 @@ func (r *ociArtefact) Close() error {
 	return err
 }
 
-func (r *ociArtefact) addArtefact(a oci.ArtefactAccess) error {
-	_, err := r.ArtefactAccess.AddArtefact(a, nil)
+func (r *ociArtefact) addArtefact(a oci.ArtefactAccess, platform *artdesc.Platform) error {
+	_, err := r.ArtefactAccess.AddArtefact(a, platform)
 	return err
 }
 
@@ -0,0 +1,33 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package builder
+
+import (
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+)
+
+// Platform sets the platform used for the actual artefact
+// when it is added to an index.
+func (b *Builder) Platform(os, arch string, variant ...string) {
+	b.expect(b.oci_platform, T_OCIARTEFACT)
+	p := &artdesc.Platform{
+		OS:           os,
+		Architecture: arch,
+	}
+	if len(variant) > 0 {
+		p.Variant = variant[0]
+	}
+	*b.oci_platform = p
+}
@@ -0,0 +1,42 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package builder
+
+import (
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+)
+
+// Subject sets the subject of the actual artefact.
+// This makes the artefact a referrer of the given one.
+func (b *Builder) Subject(desc *artdesc.Descriptor) {
+	b.expect(b.oci_artacc, T_OCIARTEFACT)
+	art := b.oci_artacc.GetDescriptor()
+	if art.IsManifest() {
+		art.Manifest().Subject = desc
+	} else {
+		art.Index().Subject = desc
+	}
+}
+
+// ArtifactType sets the artifact type of the actual artefact.
+func (b *Builder) ArtifactType(t string) {
+	b.expect(b.oci_artacc, T_OCIARTEFACT)
+	art := b.oci_artacc.GetDescriptor()
+	if art.IsManifest() {
+		art.Manifest().ArtifactType = t
+	} else {
+		art.Index().ArtifactType = t
+	}
+}
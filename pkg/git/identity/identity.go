@@ -0,0 +1,95 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package identity
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/identity/hostpath"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// CONSUMER_TYPE is the Git repository type.
+const CONSUMER_TYPE = "Git"
+
+// ID_HOSTNAME is the hostname of a Git repository.
+const ID_HOSTNAME = hostpath.ID_HOSTNAME
+
+// ID_PORT is the port number of a Git repository.
+const ID_PORT = hostpath.ID_PORT
+
+// ID_PATHPREFIX is the path of a Git repository.
+const ID_PATHPREFIX = hostpath.ID_PATHPREFIX
+
+func init() {
+	cpi.RegisterStandardIdentityMatcher(CONSUMER_TYPE, IdentityMatcher, `Git repository credential matcher
+
+It matches the <code>`+CONSUMER_TYPE+`</code> consumer type and additionally acts like 
+the <code>`+hostpath.IDENTITY_TYPE+`</code> type.`)
+}
+
+var identityMatcher = hostpath.IdentityMatcher(CONSUMER_TYPE)
+
+func IdentityMatcher(pattern, cur, id cpi.ConsumerIdentity) bool {
+	return identityMatcher(pattern, cur, id)
+}
+
+// scpLike matches the scp-like ssh syntax ([<user>@]<host>:<path>).
+var scpLike = regexp.MustCompile(`^(?:[^@/]+@)?([^:/]+):(.*)$`)
+
+// IsLocal checks whether the given repository URL describes a repository
+// in the local filesystem and returns its path.
+func IsLocal(repourl string) (string, bool) {
+	if strings.HasPrefix(repourl, "file://") {
+		return strings.TrimPrefix(repourl, "file://"), true
+	}
+	if strings.Contains(repourl, "://") || scpLike.MatchString(repourl) {
+		return "", false
+	}
+	return repourl, true
+}
+
+// GetConsumerId returns the consumer id for the given
+// Git repository URL. Besides URLs the scp-like
+// ssh syntax is supported.
+func GetConsumerId(repourl string) (credentials.ConsumerIdentity, error) {
+	var host, port, path string
+
+	if m := scpLike.FindStringSubmatch(repourl); m != nil && !strings.Contains(repourl, "://") {
+		host, path = m[1], m[2]
+	} else {
+		u, err := url.Parse(repourl)
+		if err != nil {
+			return nil, errors.ErrInvalidWrap(err, "git repository url", repourl)
+		}
+		host, port, path = u.Hostname(), u.Port(), u.Path
+	}
+	return hostpath.ConsumerIdentity(CONSUMER_TYPE, host, port, path), nil
+}
+
+// GetCredentials returns the credentials configured for the given
+// Git repository URL. If no credentials are configured, nil
+// is returned.
+func GetCredentials(cctx credentials.Context, repourl string) (credentials.Credentials, error) {
+	id, err := GetConsumerId(repourl)
+	if err != nil {
+		return nil, err
+	}
+	return hostpath.GetCredentials(cctx, id)
+}
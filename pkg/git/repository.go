@@ -0,0 +1,321 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package git
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/git/identity"
+	"github.com/open-component-model/ocm/pkg/utils/tarutils"
+)
+
+const KIND_GITREPOSITORY = "git repository"
+const KIND_GITREVISION = "git revision"
+
+const gitIgnoreFile = ".gitignore"
+
+// DefaultUser is the user name used for token and ssh key based
+// authentication if no explicit user name is configured.
+const DefaultUser = "git"
+
+// Repository is an opened Git repository.
+type Repository struct {
+	*git.Repository
+	url   string
+	local bool
+}
+
+// GetAuthMethod determines the authentication method for the given
+// repository URL from the credentials context. Supported credential
+// properties are token, username and password for https URLs
+// and privateKey (with an optional password as passphrase) for ssh URLs.
+// If no credentials are configured, nil is returned.
+func GetAuthMethod(cctx credentials.Context, repourl string) (transport.AuthMethod, error) {
+	creds, err := identity.GetCredentials(cctx, repourl)
+	if err != nil || creds == nil {
+		return nil, err
+	}
+	user := creds.GetProperty(credentials.ATTR_USERNAME)
+	if key := creds.GetProperty(credentials.ATTR_PRIVATE_KEY); key != "" {
+		if user == "" {
+			user = DefaultUser
+		}
+		auth, err := gitssh.NewPublicKeys(user, []byte(key), creds.GetProperty(credentials.ATTR_PASSWORD))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid private key for %s", repourl)
+		}
+		return auth, nil
+	}
+	if token := creds.GetProperty(credentials.ATTR_TOKEN); token != "" {
+		if user == "" {
+			user = DefaultUser
+		}
+		return &githttp.BasicAuth{Username: user, Password: token}, nil
+	}
+	if user != "" {
+		return &githttp.BasicAuth{Username: user, Password: creds.GetProperty(credentials.ATTR_PASSWORD)}, nil
+	}
+	return nil, nil
+}
+
+// Open opens the Git repository described by the given URL.
+// Repositories in the local filesystem (plain paths or file:// URLs)
+// are opened directly, all other repositories are cloned into memory.
+func Open(cctx credentials.Context, repourl string) (*Repository, error) {
+	if p, ok := identity.IsLocal(repourl); ok {
+		repo, err := git.PlainOpen(p)
+		if err != nil {
+			return nil, errors.ErrNotFoundWrap(err, KIND_GITREPOSITORY, repourl)
+		}
+		return &Repository{Repository: repo, url: repourl, local: true}, nil
+	}
+	auth, err := GetAuthMethod(cctx, repourl)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := git.Clone(memory.NewStorage(), nil, &git.CloneOptions{
+		URL:        repourl,
+		Auth:       auth,
+		NoCheckout: true,
+		Tags:       git.AllTags,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot clone %s", repourl)
+	}
+	return &Repository{Repository: repo, url: repourl}, nil
+}
+
+// Resolve determines the commit for the given ref and/or commit.
+// If a commit is given, it is used directly, otherwise the ref
+// (a branch, tag or any other reference) is resolved. If none is
+// given, HEAD is used.
+func (r *Repository) Resolve(ref, commit string) (*object.Commit, error) {
+	if commit != "" {
+		c, err := r.CommitObject(plumbing.NewHash(commit))
+		if err != nil {
+			return nil, errors.ErrNotFoundWrap(err, KIND_GITREVISION, commit, r.url)
+		}
+		return c, nil
+	}
+	if ref == "" {
+		ref = string(plumbing.HEAD)
+	}
+	candidates := []string{ref}
+	if !r.local {
+		// branches of a cloned repository are found as remote branches
+		candidates = append(candidates, git.DefaultRemoteName+"/"+strings.TrimPrefix(ref, "refs/heads/"))
+	}
+	for _, c := range candidates {
+		h, err := r.ResolveRevision(plumbing.Revision(c))
+		if err == nil {
+			return r.CommitObject(*h)
+		}
+	}
+	return nil, errors.ErrNotFound(KIND_GITREVISION, ref, r.url)
+}
+
+// ArchiveOptions describe the content of an archive
+// written for the tree of a commit.
+type ArchiveOptions struct {
+	// Path restricts the archive to the files below this path.
+	Path string
+	// IncludeFiles and ExcludeFiles are shell file name patterns
+	// matched against the path of the entries in the repository
+	// (see tarutils.TarFileSystemOptions). Entries for excluded
+	// directories are skipped together with their content.
+	IncludeFiles []string
+	ExcludeFiles []string
+	// GitIgnore enables the evaluation of the .gitignore files
+	// found in the tree.
+	GitIgnore bool
+}
+
+// WriteArchive writes a gzipped tar archive of the tree of the given commit.
+// If a path is given, only the files below this path are included.
+// The archive is reproducible (see WriteTar).
+func WriteArchive(c *object.Commit, filter string, w io.Writer) error {
+	zw := gzip.NewWriter(w)
+	if err := WriteTar(c, &ArchiveOptions{Path: filter}, zw); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// WriteTar writes a tar archive of the tree of the given commit
+// according to the given options.
+// The archive is reproducible: entries are written in tree order with
+// the commit time as modification time and without user information.
+func WriteTar(c *object.Commit, opts *ArchiveOptions, w io.Writer) error {
+	if opts == nil {
+		opts = &ArchiveOptions{}
+	}
+	tree, err := c.Tree()
+	if err != nil {
+		return err
+	}
+	filter := strings.Trim(path.Clean("/"+opts.Path), "/")
+	patterns := tarutils.TarFileSystemOptions{
+		IncludeFiles: opts.IncludeFiles,
+		ExcludeFiles: opts.ExcludeFiles,
+	}
+	var ignore gitignore.Matcher
+	if opts.GitIgnore {
+		ignore, err = gitIgnoreMatcher(tree)
+		if err != nil {
+			return err
+		}
+	}
+
+	tw := tar.NewWriter(w)
+	walker := object.NewTreeWalker(tree, true, nil)
+	defer walker.Close()
+
+	found := filter == ""
+	skip := ""
+	for {
+		name, entry, err := walker.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if filter != "" && name != filter && !strings.HasPrefix(name, filter+"/") {
+			continue
+		}
+		found = true
+		if skip != "" && strings.HasPrefix(name, skip+"/") {
+			continue
+		}
+		skip = ""
+		include, err := patterns.Included(name)
+		if err != nil {
+			return err
+		}
+		if include && ignore != nil {
+			include = !ignore.Match(strings.Split(name, "/"), entry.Mode == filemode.Dir)
+		}
+		if !include {
+			if entry.Mode == filemode.Dir {
+				skip = name
+			}
+			continue
+		}
+		if err := writeEntry(tw, tree, c.Committer.When.UTC(), name, entry); err != nil {
+			return err
+		}
+	}
+	if !found {
+		return errors.ErrNotFound("path", filter, c.Hash.String())
+	}
+	return tw.Close()
+}
+
+// gitIgnoreMatcher provides a matcher for the patterns of all
+// .gitignore files found in the given tree.
+func gitIgnoreMatcher(tree *object.Tree) (gitignore.Matcher, error) {
+	var patterns []gitignore.Pattern
+	err := tree.Files().ForEach(func(f *object.File) error {
+		if path.Base(f.Name) != gitIgnoreFile || !f.Mode.IsFile() {
+			return nil
+		}
+		content, err := f.Contents()
+		if err != nil {
+			return errors.Wrapf(err, "cannot read %s", f.Name)
+		}
+		var domain []string
+		if dir := path.Dir(f.Name); dir != "." {
+			domain = strings.Split(dir, "/")
+		}
+		for _, line := range strings.Split(content, "\n") {
+			line = strings.TrimRight(line, "\r")
+			if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			patterns = append(patterns, gitignore.ParsePattern(line, domain))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gitignore.NewMatcher(patterns), nil
+}
+
+func writeEntry(tw *tar.Writer, tree *object.Tree, mtime time.Time, name string, entry object.TreeEntry) error {
+	hdr := &tar.Header{
+		Name:    name,
+		ModTime: mtime,
+		Format:  tar.FormatPAX,
+	}
+	switch entry.Mode {
+	case filemode.Dir:
+		hdr.Typeflag = tar.TypeDir
+		hdr.Name += "/"
+		hdr.Mode = 0o755
+		return tw.WriteHeader(hdr)
+	case filemode.Submodule:
+		return nil
+	}
+
+	file, err := tree.TreeEntryFile(&entry)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get file %s", name)
+	}
+	if entry.Mode == filemode.Symlink {
+		target, err := file.Contents()
+		if err != nil {
+			return err
+		}
+		hdr.Typeflag = tar.TypeSymlink
+		hdr.Linkname = target
+		hdr.Mode = 0o777
+		return tw.WriteHeader(hdr)
+	}
+
+	hdr.Typeflag = tar.TypeReg
+	hdr.Size = file.Size
+	hdr.Mode = 0o644
+	if entry.Mode == filemode.Executable {
+		hdr.Mode = 0o755
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	r, err := file.Reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	_, err = io.Copy(tw, r)
+	return err
+}
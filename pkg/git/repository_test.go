@@ -0,0 +1,282 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package git_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/git"
+	"github.com/open-component-model/ocm/pkg/git/identity"
+	. "github.com/open-component-model/ocm/pkg/git/testhelper"
+)
+
+type entry struct {
+	name  string
+	mode  int64
+	data  string
+	mtime time.Time
+}
+
+func list(data []byte) []entry {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	Expect(err).To(Succeed())
+	data, err = io.ReadAll(zr)
+	Expect(err).To(Succeed())
+	return listTar(data)
+}
+
+func listTar(data []byte) []entry {
+	tr := tar.NewReader(bytes.NewReader(data))
+	var result []entry
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).To(Succeed())
+		Expect(hdr.Uid).To(Equal(0))
+		Expect(hdr.Gid).To(Equal(0))
+		Expect(hdr.Uname).To(Equal(""))
+		Expect(hdr.Gname).To(Equal(""))
+		content, err := io.ReadAll(tr)
+		Expect(err).To(Succeed())
+		result = append(result, entry{hdr.Name, hdr.Mode, string(content), hdr.ModTime.UTC()})
+	}
+	return result
+}
+
+var _ = Describe("git repository", func() {
+	var dir string
+	var commits []string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "gitrepo-")
+		Expect(err).To(Succeed())
+		commits, err = CreateBareRepository(dir,
+			Commit{"README.md": "first\n", "bin/run.sh": "+x echo run\n"},
+			Commit{"README.md": "second\n", "docs/guide.md": "guide\n"},
+		)
+		Expect(err).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	archive := func(ref, commit, path string) ([]byte, error) {
+		repo, err := git.Open(credentials.New(), "file://"+dir)
+		Expect(err).To(Succeed())
+		c, err := repo.Resolve(ref, commit)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		err = git.WriteArchive(c, path, &buf)
+		return buf.Bytes(), err
+	}
+
+	It("archives HEAD", func() {
+		data, err := archive("", "", "")
+		Expect(err).To(Succeed())
+		mtime := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+		Expect(list(data)).To(Equal([]entry{
+			{"README.md", 0o644, "second\n", mtime},
+			{"bin/", 0o755, "", mtime},
+			{"bin/run.sh", 0o755, "echo run\n", mtime},
+			{"docs/", 0o755, "", mtime},
+			{"docs/guide.md", 0o644, "guide\n", mtime},
+		}))
+	})
+
+	It("archives reproducibly", func() {
+		data1, err := archive("", "", "")
+		Expect(err).To(Succeed())
+		data2, err := archive("", "", "")
+		Expect(err).To(Succeed())
+		Expect(data1).To(Equal(data2))
+	})
+
+	It("resolves tags, branches and commits", func() {
+		data, err := archive("v1", "", "README.md")
+		Expect(err).To(Succeed())
+		Expect(list(data)[0].data).To(Equal("first\n"))
+
+		data, err = archive("refs/heads/main", "", "README.md")
+		Expect(err).To(Succeed())
+		Expect(list(data)[0].data).To(Equal("second\n"))
+
+		data, err = archive("", commits[0], "README.md")
+		Expect(err).To(Succeed())
+		Expect(list(data)[0].data).To(Equal("first\n"))
+	})
+
+	It("filters path", func() {
+		data, err := archive("", "", "/docs/")
+		Expect(err).To(Succeed())
+		Expect(len(list(data))).To(Equal(2))
+		Expect(list(data)[1].name).To(Equal("docs/guide.md"))
+
+		_, err = archive("v1", "", "docs")
+		Expect(err).To(MatchError(ContainSubstring("path \"docs\" not found")))
+	})
+
+	It("fails for unknown revision", func() {
+		_, err := archive("v3", "", "")
+		Expect(err).To(MatchError(ContainSubstring("git revision \"v3\" not found")))
+	})
+
+	It("fails for unknown repository", func() {
+		_, err := git.Open(credentials.New(), dir+"/unknown")
+		Expect(err).To(MatchError(ContainSubstring("git repository")))
+	})
+
+	Context("tar", func() {
+		var c *object.Commit
+
+		BeforeEach(func() {
+			os.RemoveAll(dir)
+			_, err := CreateBareRepository(dir,
+				Commit{
+					".gitignore":       "*.log\n# generated\nbuild/\n",
+					"app.log":          "log\n",
+					"build/out.bin":    "binary\n",
+					"src/.gitignore":   "gen.go\n",
+					"src/gen.go":       "generated\n",
+					"src/main.go":      "main\n",
+					"src/main_test.go": "test\n",
+				},
+			)
+			Expect(err).To(Succeed())
+			repo, err := git.Open(credentials.New(), dir)
+			Expect(err).To(Succeed())
+			c, err = repo.Resolve("", "")
+			Expect(err).To(Succeed())
+		})
+
+		tarball := func(opts *git.ArchiveOptions) []string {
+			var buf bytes.Buffer
+			Expect(git.WriteTar(c, opts, &buf)).To(Succeed())
+			var names []string
+			for _, e := range listTar(buf.Bytes()) {
+				names = append(names, e.name)
+			}
+			return names
+		}
+
+		It("honors .gitignore", func() {
+			Expect(tarball(&git.ArchiveOptions{GitIgnore: true})).To(Equal([]string{
+				".gitignore",
+				"src/",
+				"src/.gitignore",
+				"src/main.go",
+				"src/main_test.go",
+			}))
+		})
+
+		It("ignores .gitignore if not requested", func() {
+			Expect(len(tarball(nil))).To(Equal(9))
+		})
+
+		It("applies include and exclude patterns", func() {
+			Expect(tarball(&git.ArchiveOptions{
+				IncludeFiles: []string{"src", "src/*.go", "*.log"},
+				ExcludeFiles: []string{"src/*_test.go"},
+			})).To(Equal([]string{
+				"app.log",
+				"src/",
+				"src/gen.go",
+				"src/main.go",
+			}))
+			Expect(tarball(&git.ArchiveOptions{
+				ExcludeFiles: []string{"src", "build"},
+				GitIgnore:    true,
+			})).To(Equal([]string{
+				".gitignore",
+			}))
+		})
+
+		It("writes identical archives", func() {
+			var buf1, buf2 bytes.Buffer
+			Expect(git.WriteTar(c, &git.ArchiveOptions{GitIgnore: true}, &buf1)).To(Succeed())
+			Expect(git.WriteTar(c, &git.ArchiveOptions{GitIgnore: true}, &buf2)).To(Succeed())
+			Expect(buf1.Bytes()).To(Equal(buf2.Bytes()))
+		})
+	})
+
+	Context("credentials", func() {
+		var cctx credentials.Context
+
+		BeforeEach(func() {
+			cctx = credentials.New()
+		})
+
+		It("uses no auth without credentials", func() {
+			auth, err := git.GetAuthMethod(cctx, "https://git.example.com/org/repo.git")
+			Expect(err).To(Succeed())
+			Expect(auth).To(BeNil())
+		})
+
+		It("uses token for https", func() {
+			id, err := identity.GetConsumerId("https://git.example.com/org/repo.git")
+			Expect(err).To(Succeed())
+			cctx.SetCredentialsForConsumer(id, credentials.NewCredentials(common.Properties{
+				credentials.ATTR_TOKEN: "token",
+			}))
+			auth, err := git.GetAuthMethod(cctx, "https://git.example.com/org/repo.git")
+			Expect(err).To(Succeed())
+			Expect(auth).To(Equal(&githttp.BasicAuth{Username: git.DefaultUser, Password: "token"}))
+		})
+
+		It("uses ssh key for scp-like urls", func() {
+			id, err := identity.GetConsumerId("git@git.example.com:org/repo.git")
+			Expect(err).To(Succeed())
+			Expect(id).To(Equal(credentials.ConsumerIdentity{
+				credentials.CONSUMER_ATTR_TYPE: identity.CONSUMER_TYPE,
+				identity.ID_HOSTNAME:           "git.example.com",
+				identity.ID_PATHPREFIX:         "org/repo.git",
+			}))
+
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).To(Succeed())
+			keydata := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+			cctx.SetCredentialsForConsumer(id, credentials.NewCredentials(common.Properties{
+				credentials.ATTR_PRIVATE_KEY: string(keydata),
+			}))
+			auth, err := git.GetAuthMethod(cctx, "git@git.example.com:org/repo.git")
+			Expect(err).To(Succeed())
+			Expect(auth).To(BeAssignableToTypeOf(&gitssh.PublicKeys{}))
+			Expect(auth.(*gitssh.PublicKeys).User).To(Equal(git.DefaultUser))
+		})
+	})
+})
@@ -0,0 +1,90 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package testhelper
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+// Commit describes the files of a commit for a test repository.
+// Files with the prefix "+x " are executable.
+type Commit map[string]string
+
+// CreateBareRepository creates a bare Git repository in the given directory
+// with one commit per given file set on branch main. Every commit is tagged
+// with v<n>. It returns the commit hashes.
+func CreateBareRepository(dir string, commits ...Commit) ([]string, error) {
+	storage := filesystem.NewStorage(osfs.New(dir), cache.NewObjectLRUDefault())
+	repo, err := git.Init(storage, memfs.New())
+	if err != nil {
+		return nil, err
+	}
+	err = repo.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName("main")))
+	if err != nil {
+		return nil, err
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+
+	var hashes []string
+	for i, files := range commits {
+		for name, content := range files {
+			mode := os.FileMode(0o644)
+			if strings.HasPrefix(content, "+x ") {
+				mode = 0o755
+				content = content[3:]
+			}
+			f, err := wt.Filesystem.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+			if err != nil {
+				return nil, err
+			}
+			_, err = f.Write([]byte(content))
+			f.Close()
+			if err != nil {
+				return nil, err
+			}
+			if _, err = wt.Add(name); err != nil {
+				return nil, err
+			}
+		}
+		sig := &object.Signature{
+			Name:  "test",
+			Email: "test@example.com",
+			When:  time.Date(2022, 1, i+1, 0, 0, 0, 0, time.UTC),
+		}
+		h, err := wt.Commit("commit", &git.CommitOptions{Author: sig, Committer: sig})
+		if err != nil {
+			return nil, err
+		}
+		if _, err = repo.CreateTag(fmt.Sprintf("v%d", i+1), h, nil); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, h.String())
+	}
+	return hashes, nil
+}
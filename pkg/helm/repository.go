@@ -0,0 +1,199 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package helm
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+	"helm.sh/helm/v3/pkg/repo"
+	"sigs.k8s.io/yaml"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio/downloader"
+	hd "github.com/open-component-model/ocm/pkg/common/accessio/downloader/http"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/ociutils/helm"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ocireg"
+	httpaccess "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/http"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/helm/identity"
+)
+
+const KIND_HELMCHART = "helm chart"
+
+// OCIScheme is the URL scheme used for Helm charts stored in OCI registries.
+const OCIScheme = "oci://"
+
+// IsOCIRepository checks whether the given repository URL describes
+// an OCI registry instead of a classic Helm chart repository.
+func IsOCIRepository(repourl string) bool {
+	return strings.HasPrefix(repourl, OCIScheme)
+}
+
+// OCIReference returns the OCI artefact reference for a Helm chart
+// stored in an OCI registry.
+func OCIReference(repourl, name, version string) string {
+	ref := strings.TrimSuffix(strings.TrimPrefix(repourl, OCIScheme), "/") + "/" + name
+	if version != "" {
+		ref += ":" + version
+	}
+	return ref
+}
+
+// Chart is a chart archive downloaded from a Helm chart repository.
+type Chart struct {
+	Name       string
+	Version    string
+	Archive    []byte
+	Provenance []byte
+}
+
+// DownloadChart downloads a chart archive and, if available, its provenance
+// file from a classic Helm chart repository described by an index.yaml.
+// Credentials for the repository are taken from the given credentials context.
+func DownloadChart(cctx credentials.Context, repourl, name, version string) (*Chart, error) {
+	if IsOCIRepository(repourl) {
+		return nil, errors.ErrNotSupported("oci repository", repourl, "helm chart download")
+	}
+	creds, err := identity.GetCredentials(cctx, repourl)
+	if err != nil {
+		return nil, err
+	}
+	base, err := url.Parse(repourl)
+	if err != nil {
+		return nil, errors.ErrInvalidWrap(err, "helm repository url", repourl)
+	}
+
+	u := *base
+	u.Path = path.Join(u.Path, "index.yaml")
+	data, err := get(creds, base, u.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read index of helm repository %q", repourl)
+	}
+	idx := &repo.IndexFile{}
+	if err := yaml.Unmarshal(data, idx); err != nil {
+		return nil, errors.ErrInvalidWrap(err, "helm repository index", repourl)
+	}
+	idx.SortEntries()
+	cv, err := idx.Get(name, version)
+	if err != nil {
+		return nil, errors.ErrNotFoundWrap(err, KIND_HELMCHART, name+":"+version, repourl)
+	}
+	if len(cv.URLs) == 0 {
+		return nil, errors.ErrInvalid(KIND_HELMCHART, name+":"+cv.Version, repourl)
+	}
+	chartURL, err := repo.ResolveReferenceURL(repourl, cv.URLs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	chart := &Chart{
+		Name:    cv.Name,
+		Version: cv.Version,
+	}
+	chart.Archive, err = get(creds, base, chartURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot download helm chart %s:%s", name, cv.Version)
+	}
+	if cv.Digest != "" {
+		if d := digest.FromBytes(chart.Archive); d.Encoded() != cv.Digest {
+			return nil, errors.Newf("digest mismatch for helm chart %s:%s: expected %s, found %s", name, cv.Version, cv.Digest, d.Encoded())
+		}
+	}
+	prov, err := get(creds, base, chartURL+".prov")
+	if err != nil {
+		if !errors.IsErrNotFound(err) {
+			return nil, errors.Wrapf(err, "cannot download provenance file for helm chart %s:%s", name, cv.Version)
+		}
+	} else {
+		chart.Provenance = prov
+	}
+	return chart, nil
+}
+
+// SynthesizeArtefactBlob provides a Helm chart as OCI artefact set blob.
+// For classic Helm chart repositories the chart is downloaded and packed
+// like it is done for Helm charts stored in OCI registries. For OCI
+// repositories (scheme oci://) the chart artefact is read from the registry.
+func SynthesizeArtefactBlob(ctx oci.Context, repourl, name, version string) (artefactset.ArtefactBlob, error) {
+	if IsOCIRepository(repourl) {
+		return synthesizeOCIArtefactBlob(ctx, repourl, name, version)
+	}
+	chart, err := DownloadChart(ctx.CredentialsContext(), repourl, name, version)
+	if err != nil {
+		return nil, err
+	}
+	fs := memoryfs.New()
+	file := fmt.Sprintf("/%s-%s.tgz", chart.Name, chart.Version)
+	err = vfs.WriteFile(fs, file, chart.Archive, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if chart.Provenance != nil {
+		err = vfs.WriteFile(fs, file+".prov", chart.Provenance, 0o600)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return helm.SynthesizeArtefactBlob(file, fs)
+}
+
+func synthesizeOCIArtefactBlob(ctx oci.Context, repourl, name, version string) (artefactset.ArtefactBlob, error) {
+	ref, err := oci.ParseRef(OCIReference(repourl, name, version))
+	if err != nil {
+		return nil, err
+	}
+	spec := ctx.GetAlias(ref.Host)
+	if spec == nil {
+		spec = ocireg.NewRepositorySpec(ref.Host)
+	}
+	r, err := ctx.RepositoryForSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	ns, err := r.LookupNamespace(ref.Repository)
+	if err != nil {
+		return nil, err
+	}
+	defer ns.Close()
+	return artefactset.SynthesizeArtefactBlob(ns, ref.Version())
+}
+
+// get reads the content of the given URL. Credentials are only
+// passed to the host of the repository.
+func get(creds credentials.Credentials, base *url.URL, u string) ([]byte, error) {
+	target, err := url.Parse(u)
+	if err != nil {
+		return nil, errors.ErrInvalidWrap(err, "url", u)
+	}
+	var opts []hd.Option
+	if target.Host == base.Host {
+		opts = httpaccess.DownloaderOptions(creds)
+	}
+	var buf downloader.Buffer
+	err = hd.NewDownloader(u, opts...).Download(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package helm_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artefactset"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/helm"
+	"github.com/open-component-model/ocm/pkg/helm/identity"
+)
+
+var _ = Describe("helm chart repository", func() {
+	var dir string
+	var server *httptest.Server
+	var auth bool
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "helmrepo-")
+		Expect(err).To(Succeed())
+		chart, err := loader.LoadDir(filepath.Join("testdata", "testchart"))
+		Expect(err).To(Succeed())
+		_, err = chartutil.Save(chart, dir)
+		Expect(err).To(Succeed())
+		index, err := repo.IndexDirectory(dir, "")
+		Expect(err).To(Succeed())
+		Expect(index.WriteFile(filepath.Join(dir, "index.yaml"), 0o600)).To(Succeed())
+
+		auth = false
+		files := http.FileServer(http.Dir(dir))
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if auth {
+				if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+			}
+			files.ServeHTTP(w, r)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	It("downloads a chart", func() {
+		chart, err := helm.DownloadChart(credentials.New(), server.URL, "testchart", "0.1.0")
+		Expect(err).To(Succeed())
+		Expect(chart.Name).To(Equal("testchart"))
+		Expect(chart.Version).To(Equal("0.1.0"))
+		data, err := os.ReadFile(filepath.Join(dir, "testchart-0.1.0.tgz"))
+		Expect(err).To(Succeed())
+		Expect(chart.Archive).To(Equal(data))
+		Expect(chart.Provenance).To(BeNil())
+	})
+
+	It("uses credentials", func() {
+		auth = true
+		cctx := credentials.New()
+		_, err := helm.DownloadChart(cctx, server.URL, "testchart", "0.1.0")
+		Expect(err).To(HaveOccurred())
+
+		id, err := identity.GetConsumerId(server.URL)
+		Expect(err).To(Succeed())
+		cctx.SetCredentialsForConsumer(id, credentials.NewCredentials(common.Properties{
+			credentials.ATTR_USERNAME: "user",
+			credentials.ATTR_PASSWORD: "pass",
+		}))
+		chart, err := helm.DownloadChart(cctx, server.URL, "testchart", "0.1.0")
+		Expect(err).To(Succeed())
+		Expect(chart.Version).To(Equal("0.1.0"))
+	})
+
+	It("fails for unknown chart version", func() {
+		_, err := helm.DownloadChart(credentials.New(), server.URL, "testchart", "0.2.0")
+		Expect(errors.IsErrNotFoundKind(err, helm.KIND_HELMCHART)).To(BeTrue())
+	})
+
+	It("synthesizes an artefact set", func() {
+		blob, err := helm.SynthesizeArtefactBlob(oci.New(), server.URL, "testchart", "0.1.0")
+		Expect(err).To(Succeed())
+		defer blob.Close()
+
+		set, err := artefactset.OpenFromBlob(accessobj.ACC_READONLY, blob)
+		Expect(err).To(Succeed())
+		defer set.Close()
+		art, err := set.GetArtefact(set.GetMain().String())
+		Expect(err).To(Succeed())
+		defer art.Close()
+		Expect(art.ManifestAccess().GetDescriptor().Config.MediaType).To(Equal(registry.ConfigMediaType))
+		Expect(art.ManifestAccess().GetDescriptor().Layers[0].MediaType).To(Equal(registry.ChartLayerMediaType))
+		Expect(set.GetTags(set.GetMain())).To(ContainElement("0.1.0"))
+	})
+})
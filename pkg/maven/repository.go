@@ -0,0 +1,212 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package maven
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio/downloader"
+	hd "github.com/open-component-model/ocm/pkg/common/accessio/downloader/http"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	httpaccess "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/http"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/maven/identity"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const KIND_MAVENARTIFACT = "maven artifact"
+
+// FileScheme is the URL scheme used for local directories
+// with a Maven repository layout.
+const FileScheme = "file://"
+
+// Coordinates describe a file of an artifact in a Maven repository.
+type Coordinates struct {
+	GroupId    string
+	ArtifactId string
+	Version    string
+	Classifier string
+	Extension  string
+}
+
+// GetExtension returns the file extension of the artifact.
+// It defaults to jar.
+func (c *Coordinates) GetExtension() string {
+	if c.Extension == "" {
+		return "jar"
+	}
+	return c.Extension
+}
+
+// FileName returns the name of the artifact file.
+func (c *Coordinates) FileName() string {
+	n := c.ArtifactId + "-" + c.Version
+	if c.Classifier != "" {
+		n += "-" + c.Classifier
+	}
+	return n + "." + c.GetExtension()
+}
+
+// FilePath returns the path of the artifact file relative to
+// the repository root.
+func (c *Coordinates) FilePath() string {
+	return path.Join(strings.ReplaceAll(c.GroupId, ".", "/"), c.ArtifactId, c.Version, c.FileName())
+}
+
+// MimeType returns the media type of the artifact file
+// derived from its extension.
+func (c *Coordinates) MimeType() string {
+	switch c.GetExtension() {
+	case "jar", "war", "ear":
+		return mime.MIME_JAR
+	case "pom", "xml":
+		return mime.MIME_XML
+	case "zip":
+		return mime.MIME_ZIP
+	case "tar.gz", "tgz":
+		return mime.MIME_TGZ
+	default:
+		return mime.MIME_OCTET
+	}
+}
+
+func (c *Coordinates) String() string {
+	s := c.GroupId + ":" + c.ArtifactId + ":" + c.Version
+	if c.Classifier != "" {
+		s += ":" + c.Classifier
+	}
+	return s + ":" + c.GetExtension()
+}
+
+// Validate checks whether the mandatory coordinates are given.
+func (c *Coordinates) Validate() error {
+	if c.GroupId == "" {
+		return errors.ErrInvalid("groupId", "")
+	}
+	if c.ArtifactId == "" {
+		return errors.ErrInvalid("artifactId", "")
+	}
+	if c.Version == "" {
+		return errors.Newf("version required for maven artifact %s:%s", c.GroupId, c.ArtifactId)
+	}
+	return nil
+}
+
+// checksums are the checksum files provided by Maven repositories
+// in the order of preference. Weak checksums are verified, if present,
+// but are not sufficient to accept an artifact.
+var checksums = []struct {
+	suffix string
+	hash   func() hash.Hash
+	weak   bool
+}{
+	{suffix: ".sha512", hash: sha512.New},
+	{suffix: ".sha256", hash: sha256.New},
+	{suffix: ".sha1", hash: sha1.New},
+	{suffix: ".md5", hash: md5.New, weak: true},
+}
+
+// Download reads the artifact file described by the given coordinates
+// from a Maven repository. The content is verified against all
+// checksum files provided by the repository. At least one sha1, sha256
+// or sha512 checksum is required, an md5 checksum is not sufficient.
+// Repositories given by a file:// URL are read from the given filesystem.
+func Download(fs vfs.FileSystem, cctx credentials.Context, repourl string, coords *Coordinates) ([]byte, error) {
+	if err := coords.Validate(); err != nil {
+		return nil, err
+	}
+	var get func(p string) ([]byte, error)
+
+	if strings.HasPrefix(repourl, FileScheme) {
+		root := strings.TrimPrefix(repourl, FileScheme)
+		get = func(p string) ([]byte, error) {
+			data, err := vfs.ReadFile(fs, path.Join(root, p))
+			if vfs.IsErrNotExist(err) {
+				return nil, errors.ErrNotFound("file", path.Join(root, p))
+			}
+			return data, err
+		}
+	} else {
+		base, err := url.Parse(repourl)
+		if err != nil {
+			return nil, errors.ErrInvalidWrap(err, "maven repository url", repourl)
+		}
+		if base.Scheme != "http" && base.Scheme != "https" {
+			return nil, errors.ErrInvalid("maven repository url", repourl)
+		}
+		creds, err := identity.GetCredentials(cctx, repourl)
+		if err != nil {
+			return nil, err
+		}
+		get = func(p string) ([]byte, error) {
+			return getURL(creds, strings.TrimSuffix(repourl, "/")+"/"+p)
+		}
+	}
+
+	file := coords.FilePath()
+	data, err := get(file)
+	if err != nil {
+		if errors.IsErrNotFound(err) {
+			return nil, errors.ErrNotFoundWrap(err, KIND_MAVENARTIFACT, coords.String(), repourl)
+		}
+		return nil, err
+	}
+
+	found := false
+	for _, c := range checksums {
+		sum, err := get(file + c.suffix)
+		if err != nil {
+			if errors.IsErrNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		found = found || !c.weak
+		// checksum files may contain the file name after the checksum
+		fields := strings.Fields(string(sum))
+		if len(fields) == 0 {
+			return nil, errors.Newf("empty checksum file %s%s", file, c.suffix)
+		}
+		h := c.hash()
+		h.Write(data)
+		if actual := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(actual, fields[0]) {
+			return nil, errors.Newf("%s checksum mismatch for %s: expected %s, found %s", strings.TrimPrefix(c.suffix, "."), coords, fields[0], actual)
+		}
+	}
+	if !found {
+		return nil, errors.Newf("no sha512, sha256 or sha1 checksum found for %s in repository %s", coords, repourl)
+	}
+	return data, nil
+}
+
+// getURL reads the content of the given URL.
+func getURL(creds credentials.Credentials, u string) ([]byte, error) {
+	var buf downloader.Buffer
+	err := hd.NewDownloader(u, httpaccess.DownloaderOptions(creds)...).Download(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
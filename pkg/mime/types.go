@@ -26,4 +26,8 @@ const (
 	MIME_GZIP = "application/gzip"
 	MIME_TAR  = "application/x-tar"
 	MIME_TGZ  = "application/x-tgz"
+	MIME_ZIP  = "application/zip"
+
+	MIME_JAR = "application/java-archive"
+	MIME_XML = "application/xml"
 )
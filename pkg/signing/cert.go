@@ -117,11 +117,16 @@ func RootPool(pemfile string, useOS bool, fss ...vfs.FileSystem) (*x509.CertPool
 }
 
 func VerifyCert(intermediate, root *x509.CertPool, cn string, cert *x509.Certificate) error {
+	return VerifyCertAt(intermediate, root, cn, cert, time.Now())
+}
+
+// VerifyCertAt verifies a code signing certificate for the given time.
+func VerifyCertAt(intermediate, root *x509.CertPool, cn string, cert *x509.Certificate, at time.Time) error {
 	opts := x509.VerifyOptions{
 		DNSName:       cn,
 		Intermediates: intermediate,
 		Roots:         root,
-		CurrentTime:   time.Now(),
+		CurrentTime:   at,
 		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
 	}
 	_, err := cert.Verify(opts)
@@ -146,8 +151,31 @@ func VerifyCert(intermediate, root *x509.CertPool, cn string, cert *x509.Certifi
 	return errors.ErrNotSupported("codesign", "", "certificate")
 }
 
+// IsExpired checks whether a certificate verification error is caused by
+// an expired certificate.
+func IsExpired(err error) bool {
+	var cerr x509.CertificateInvalidError
+	return errors.As(err, &cerr) && cerr.Reason == x509.Expired
+}
+
 func CreateCertificate(subject pkix.Name, validFrom *time.Time, validity time.Duration,
 	pub interface{}, ca *x509.Certificate, priv interface{}, isCA bool, names ...string,
+) ([]byte, error) {
+	return createCertificate(subject, validFrom, validity, pub, ca, priv, isCA, x509.ExtKeyUsageCodeSigning, names...)
+}
+
+// CreateTimestampingCertificate creates a certificate for an RFC 3161
+// timestamp authority or a certificate authority issuing such certificates.
+// According to RFC 3161 it carries the timestamping extended key usage
+// as the only extended key usage.
+func CreateTimestampingCertificate(subject pkix.Name, validFrom *time.Time, validity time.Duration,
+	pub interface{}, ca *x509.Certificate, priv interface{}, isCA bool,
+) ([]byte, error) {
+	return createCertificate(subject, validFrom, validity, pub, ca, priv, isCA, x509.ExtKeyUsageTimeStamping)
+}
+
+func createCertificate(subject pkix.Name, validFrom *time.Time, validity time.Duration,
+	pub interface{}, ca *x509.Certificate, priv interface{}, isCA bool, usage x509.ExtKeyUsage, names ...string,
 ) ([]byte, error) {
 	var notBefore time.Time
 
@@ -174,7 +202,7 @@ func CreateCertificate(subject pkix.Name, validFrom *time.Time, validity time.Du
 		NotAfter:     notAfter,
 
 		KeyUsage:              x509.KeyUsageDigitalSignature,
-		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		ExtKeyUsage:           []x509.ExtKeyUsage{usage},
 		BasicConstraintsValid: true,
 	}
 
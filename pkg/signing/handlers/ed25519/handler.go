@@ -0,0 +1,133 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ed25519
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+)
+
+// Algorithm defines the type for the Ed25519 signature algorithm.
+const Algorithm = "Ed25519"
+
+// MediaType defines the media type for a plain Ed25519 signature.
+const MediaType = "application/vnd.ocm.signature.ed25519"
+
+func init() {
+	signing.DefaultHandlerRegistry().RegisterSignatureHandler(Handler{})
+}
+
+type (
+	PrivateKey = ed25519.PrivateKey
+	PublicKey  = ed25519.PublicKey
+)
+
+// Handler is a signatures.Signer compatible struct to sign with Ed25519.
+// and a signatures.Verifier compatible struct to verify Ed25519 signatures.
+// The signed message is the digest to sign.
+type Handler struct{}
+
+var _ signing.SignatureHandler = Handler{}
+
+func (h Handler) Algorithm() string {
+	return Algorithm
+}
+
+func (h Handler) Sign(digest string, hash crypto.Hash, issuer string, key interface{}) (signature *signing.Signature, err error) {
+	privateKey, err := GetSigner(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid ed25519 private key")
+	}
+	decodedHash, err := hex.DecodeString(digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding hash to bytes")
+	}
+	sig, err := privateKey.Sign(rand.Reader, decodedHash, crypto.Hash(0))
+	if err != nil {
+		return nil, fmt.Errorf("failed signing hash, %w", err)
+	}
+	return &signing.Signature{
+		Value:     hex.EncodeToString(sig),
+		MediaType: MediaType,
+		Algorithm: Algorithm,
+		Issuer:    issuer,
+	}, nil
+}
+
+// Verify checks the signature, returns an error on verification failure.
+func (h Handler) Verify(digest string, hash crypto.Hash, signature *signing.Signature, key interface{}) (err error) {
+	var signatureBytes []byte
+
+	publicKey, names, err := GetPublicKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to get public key: %w", err)
+	}
+
+	switch signature.MediaType {
+	case MediaType:
+		signatureBytes, err = hex.DecodeString(signature.Value)
+		if err != nil {
+			return fmt.Errorf("unable to get signature value: failed decoding hash %s: %w", digest, err)
+		}
+	case rsa.MediaTypePEM:
+		signaturePemBlocks, err := rsa.GetSignaturePEMBlocks([]byte(signature.Value))
+		if err != nil {
+			return fmt.Errorf("unable to get signature pem blocks: %w", err)
+		}
+		if len(signaturePemBlocks) != 1 {
+			return fmt.Errorf("expected 1 signature pem block, found %d", len(signaturePemBlocks))
+		}
+		signatureBytes = signaturePemBlocks[0].Bytes
+	default:
+		return fmt.Errorf("invalid signature mediaType %s", signature.MediaType)
+	}
+
+	decodedHash, err := hex.DecodeString(digest)
+	if err != nil {
+		return fmt.Errorf("failed decoding hash %s: %w", digest, err)
+	}
+
+	if names != nil && signature.Issuer != "" {
+		found := false
+		for _, n := range names {
+			if n == signature.Issuer {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("issuer %q does not match %v", signature.Issuer, names)
+		}
+	}
+	if !ed25519.Verify(publicKey, decodedHash, signatureBytes) {
+		return fmt.Errorf("signature verification failed")
+	}
+	return nil
+}
+
+func (_ Handler) CreateKeyPair() (priv interface{}, pub interface{}, err error) {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	return privKey, pubKey, nil
+}
@@ -15,6 +15,9 @@
 package handlers
 
 import (
+	_ "github.com/open-component-model/ocm/pkg/signing/handlers/ecdsa"
+	_ "github.com/open-component-model/ocm/pkg/signing/handlers/ed25519"
 	_ "github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
 	_ "github.com/open-component-model/ocm/pkg/signing/handlers/rsa-signingservice"
+	_ "github.com/open-component-model/ocm/pkg/signing/handlers/sigstore"
 )
@@ -16,6 +16,7 @@ package rsa
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
@@ -59,6 +60,19 @@ func GetPrivateKey(key interface{}) (*rsa.PrivateKey, error) {
 	}
 }
 
+// GetSigner provides a crypto.Signer for a private key. Besides the key
+// formats accepted by GetPrivateKey, signers for keys kept by a
+// signing.KeyProvider are accepted.
+func GetSigner(key interface{}) (crypto.Signer, error) {
+	if s, ok := key.(crypto.Signer); ok {
+		if _, ok := s.Public().(*rsa.PublicKey); !ok {
+			return nil, fmt.Errorf("no rsa key (found %T)", s.Public())
+		}
+		return s, nil
+	}
+	return GetPrivateKey(key)
+}
+
 func WriteKeyData(key interface{}, w io.Writer) error {
 	block := PemBlockForKey(key)
 	return pem.Encode(w, block)
@@ -61,7 +61,7 @@ func (h Handler) Algorithm() string {
 }
 
 func (h Handler) Sign(digest string, hash crypto.Hash, issuer string, key interface{}) (signature *signing.Signature, err error) {
-	privateKey, err := GetPrivateKey(key)
+	privateKey, err := GetSigner(key)
 	if err != nil {
 		return nil, errors.Wrapf(err, "invalid rsa private key")
 	}
@@ -73,7 +73,7 @@ func (h Handler) Sign(digest string, hash crypto.Hash, issuer string, key interf
 	//if len(decodedHash) != 32 {
 	//	return "", "", fmt.Errorf("hash to sign has invalid length")
 	//}
-	sig, err := rsa.SignPKCS1v15(rand.Reader, privateKey, hash, decodedHash)
+	sig, err := privateKey.Sign(rand.Reader, decodedHash, hash)
 	if err != nil {
 		return nil, fmt.Errorf("failed signing hash, %w", err)
 	}
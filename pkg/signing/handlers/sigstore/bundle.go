@@ -0,0 +1,123 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package sigstore
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// Bundle is the subset of the sigstore bundle format (version 0.1)
+// required to describe a message signature. Transparency log entries
+// and timestamps are not supported.
+type Bundle struct {
+	MediaType            string               `json:"mediaType"`
+	VerificationMaterial VerificationMaterial `json:"verificationMaterial"`
+	MessageSignature     MessageSignature     `json:"messageSignature"`
+}
+
+// VerificationMaterial describes the key used for the signature, either
+// by a hint for a well-known public key or by a certificate chain.
+type VerificationMaterial struct {
+	PublicKey            *PublicKeyIdentifier  `json:"publicKey,omitempty"`
+	X509CertificateChain *X509CertificateChain `json:"x509CertificateChain,omitempty"`
+}
+
+type PublicKeyIdentifier struct {
+	Hint string `json:"hint,omitempty"`
+}
+
+type X509CertificateChain struct {
+	Certificates []X509Certificate `json:"certificates"`
+}
+
+type X509Certificate struct {
+	RawBytes []byte `json:"rawBytes"`
+}
+
+type MessageSignature struct {
+	MessageDigest HashOutput `json:"messageDigest"`
+	Signature     []byte     `json:"signature"`
+}
+
+type HashOutput struct {
+	Algorithm string `json:"algorithm"`
+	Digest    []byte `json:"digest"`
+}
+
+// HashAlgorithm maps a crypto hash to its sigstore name.
+func HashAlgorithm(hash crypto.Hash) (string, error) {
+	switch hash {
+	case crypto.SHA256:
+		return "SHA2_256", nil
+	case crypto.SHA384:
+		return "SHA2_384", nil
+	case crypto.SHA512:
+		return "SHA2_512", nil
+	default:
+		return "", errors.ErrNotSupported("hash algorithm", hash.String(), Algorithm)
+	}
+}
+
+// KeyHint provides the key hint used to identify a public key.
+func KeyHint(pub crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return base64.StdEncoding.EncodeToString(sum[:]), nil
+}
+
+func ParseBundle(data []byte) (*Bundle, error) {
+	var bundle Bundle
+	err := json.Unmarshal(data, &bundle)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid sigstore bundle")
+	}
+	if bundle.MediaType != MediaType {
+		return nil, fmt.Errorf("unsupported sigstore bundle media type %q", bundle.MediaType)
+	}
+	return &bundle, nil
+}
+
+// Certificates returns the leaf certificate and the intermediate certificates
+// found in the certificate chain of the bundle.
+func (b *Bundle) Certificates() (*x509.Certificate, *x509.CertPool, error) {
+	chain := b.VerificationMaterial.X509CertificateChain
+	if chain == nil || len(chain.Certificates) == 0 {
+		return nil, nil, nil
+	}
+	var leaf *x509.Certificate
+	pool := x509.NewCertPool()
+	for i, c := range chain.Certificates {
+		cert, err := x509.ParseCertificate(c.RawBytes)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid certificate %d in sigstore bundle", i)
+		}
+		if i == 0 {
+			leaf = cert
+		} else {
+			pool.AddCert(cert)
+		}
+	}
+	return leaf, pool, nil
+}
@@ -0,0 +1,249 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package sigstore
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+	ecdsahandler "github.com/open-component-model/ocm/pkg/signing/handlers/ecdsa"
+)
+
+// Algorithm defines the type for ECDSA signatures stored in the
+// sigstore bundle format.
+const Algorithm = "sigstore"
+
+// MediaType defines the media type of a sigstore bundle.
+const MediaType = "application/vnd.dev.sigstore.bundle+json;version=0.1"
+
+func init() {
+	signing.DefaultHandlerRegistry().RegisterSignatureHandler(Handler{})
+}
+
+// Handler is a signatures.Signer compatible struct to sign with ECDSA
+// keys providing a sigstore bundle, and a signatures.Verifier compatible
+// struct to verify such bundles offline against a local trust root.
+//
+// For signing the key may consist of a sequence of PEM blocks providing the
+// private key followed by an optional certificate chain for it (leaf
+// certificate first). The chain is embedded into the bundle.
+//
+// For verification the key is either a public key or a sequence of PEM
+// encoded root certificates used to validate the certificate chain found
+// in the bundle. No transparency log or certificate authority is contacted.
+type Handler struct{}
+
+var _ signing.SignatureHandler = Handler{}
+
+func (h Handler) Algorithm() string {
+	return Algorithm
+}
+
+func (h Handler) Sign(digest string, hash crypto.Hash, issuer string, key interface{}) (signature *signing.Signature, err error) {
+	privateKey, chain, err := getSigningKey(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid sigstore signing key")
+	}
+	publicKey, ok := privateKey.Public().(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("no ecdsa key (found %T)", privateKey.Public())
+	}
+	halgo, err := HashAlgorithm(hash)
+	if err != nil {
+		return nil, err
+	}
+	decodedHash, err := hex.DecodeString(digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding hash to bytes")
+	}
+	sig, err := ecdsahandler.Handler{}.Sign(digest, hash, issuer, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	sigBytes, err := hex.DecodeString(sig.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &Bundle{
+		MediaType: MediaType,
+		MessageSignature: MessageSignature{
+			MessageDigest: HashOutput{
+				Algorithm: halgo,
+				Digest:    decodedHash,
+			},
+			Signature: sigBytes,
+		},
+	}
+	if len(chain) > 0 {
+		if !publicKey.Equal(chain[0].PublicKey) {
+			return nil, fmt.Errorf("certificate does not match private key")
+		}
+		certs := &X509CertificateChain{}
+		for _, c := range chain {
+			certs.Certificates = append(certs.Certificates, X509Certificate{RawBytes: c.Raw})
+		}
+		bundle.VerificationMaterial.X509CertificateChain = certs
+	} else {
+		hint, err := KeyHint(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		bundle.VerificationMaterial.PublicKey = &PublicKeyIdentifier{Hint: hint}
+	}
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+	return &signing.Signature{
+		Value:     string(data),
+		MediaType: MediaType,
+		Algorithm: Algorithm,
+		Issuer:    issuer,
+	}, nil
+}
+
+// Verify checks the signature, returns an error on verification failure.
+func (h Handler) Verify(digest string, hash crypto.Hash, signature *signing.Signature, key interface{}) (err error) {
+	if signature.MediaType != MediaType {
+		return fmt.Errorf("invalid signature mediaType %s", signature.MediaType)
+	}
+	bundle, err := ParseBundle([]byte(signature.Value))
+	if err != nil {
+		return err
+	}
+
+	halgo, err := HashAlgorithm(hash)
+	if err != nil {
+		return err
+	}
+	decodedHash, err := hex.DecodeString(digest)
+	if err != nil {
+		return fmt.Errorf("failed decoding hash %s: %w", digest, err)
+	}
+	md := bundle.MessageSignature.MessageDigest
+	if md.Algorithm != halgo {
+		return fmt.Errorf("hash algorithm %q does not match %q", md.Algorithm, halgo)
+	}
+	if !bytes.Equal(md.Digest, decodedHash) {
+		return fmt.Errorf("message digest does not match")
+	}
+
+	roots, pub, err := getTrustRoot(key)
+	if err != nil {
+		return fmt.Errorf("failed to get trust root: %w", err)
+	}
+
+	leaf, intermediates, err := bundle.Certificates()
+	if err != nil {
+		return err
+	}
+	switch {
+	case roots != nil:
+		if leaf == nil {
+			return fmt.Errorf("sigstore bundle contains no certificate chain")
+		}
+		err = signing.VerifyCert(intermediates, roots, signature.Issuer, leaf)
+		if err != nil {
+			return errors.Wrapf(err, "certificate chain")
+		}
+		p, ok := leaf.PublicKey.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("unsupported public key %T in certificate", leaf.PublicKey)
+		}
+		pub = p
+	case leaf != nil:
+		if !pub.Equal(leaf.PublicKey) {
+			return fmt.Errorf("public key does not match certificate")
+		}
+	}
+	if !ecdsa.VerifyASN1(pub, decodedHash, bundle.MessageSignature.Signature) {
+		return fmt.Errorf("signature verification failed")
+	}
+	return nil
+}
+
+func (_ Handler) CreateKeyPair() (priv interface{}, pub interface{}, err error) {
+	return ecdsahandler.Handler{}.CreateKeyPair()
+}
+
+// getSigningKey provides the signer for a signing key and the certificate
+// chain optionally found along with the key. Besides the key formats
+// accepted by the ecdsa handler, signers for keys kept by a
+// signing.KeyProvider are accepted.
+func getSigningKey(key interface{}) (crypto.Signer, []*x509.Certificate, error) {
+	data, ok := key.([]byte)
+	if !ok {
+		k, err := ecdsahandler.GetSigner(key)
+		return k, nil, err
+	}
+	var priv crypto.Signer
+	var chain []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, err
+			}
+			chain = append(chain, cert)
+			continue
+		}
+		if priv != nil {
+			return nil, nil, fmt.Errorf("multiple private keys found")
+		}
+		k, err := ecdsahandler.ParsePrivateKey(pem.EncodeToMemory(block))
+		if err != nil {
+			return nil, nil, err
+		}
+		priv = k
+	}
+	if priv == nil {
+		return nil, nil, fmt.Errorf("no private key found")
+	}
+	return priv, chain, nil
+}
+
+// getTrustRoot provides either a root certificate pool or a public key
+// used to verify a bundle.
+func getTrustRoot(key interface{}) (*x509.CertPool, *ecdsa.PublicKey, error) {
+	switch k := key.(type) {
+	case *x509.CertPool:
+		return k, nil, nil
+	case *x509.Certificate:
+		pool := x509.NewCertPool()
+		pool.AddCert(k)
+		return pool, nil, nil
+	case []byte:
+		pool := x509.NewCertPool()
+		if pool.AppendCertsFromPEM(k) {
+			return pool, nil, nil
+		}
+	}
+	pub, _, err := ecdsahandler.GetPublicKey(key)
+	return nil, pub, err
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package signing
+
+import (
+	"crypto"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+const KIND_KEY_PROVIDER = "key provider"
+
+// KeyProvider provides access to private keys kept in external key stores,
+// like hardware security modules or key management services.
+// Keys are described by a key reference of the form <scheme>:<spec>.
+// The key material is never exported, instead a crypto.Signer is provided,
+// which can be used by signature handlers to sign a digest.
+type KeyProvider interface {
+	// Scheme is the reference scheme handled by the provider.
+	Scheme() string
+	// GetSigner provides a signer for the key described by the
+	// scheme specific part of a key reference.
+	GetSigner(spec string) (crypto.Signer, error)
+}
+
+type KeyProviderRegistry interface {
+	RegisterKeyProvider(p KeyProvider)
+	GetKeyProvider(scheme string) KeyProvider
+	KeyProviderSchemes() []string
+
+	// IsKeyReference checks whether the given string is a key reference
+	// for a registered key provider.
+	IsKeyReference(ref string) bool
+	// ResolveKeyReference provides a signer for a key reference.
+	ResolveKeyReference(ref string) (crypto.Signer, error)
+}
+
+type keyProviderRegistry struct {
+	lock      sync.RWMutex
+	providers map[string]KeyProvider
+}
+
+var _ KeyProviderRegistry = (*keyProviderRegistry)(nil)
+
+func NewKeyProviderRegistry() KeyProviderRegistry {
+	return &keyProviderRegistry{
+		providers: map[string]KeyProvider{},
+	}
+}
+
+func (r *keyProviderRegistry) RegisterKeyProvider(p KeyProvider) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.providers[p.Scheme()] = p
+}
+
+func (r *keyProviderRegistry) GetKeyProvider(scheme string) KeyProvider {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.providers[scheme]
+}
+
+func (r *keyProviderRegistry) KeyProviderSchemes() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	var names []string
+	for n := range r.providers {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *keyProviderRegistry) IsKeyReference(ref string) bool {
+	i := strings.Index(ref, ":")
+	return i > 0 && r.GetKeyProvider(ref[:i]) != nil
+}
+
+func (r *keyProviderRegistry) ResolveKeyReference(ref string) (crypto.Signer, error) {
+	i := strings.Index(ref, ":")
+	if i <= 0 {
+		return nil, errors.ErrInvalid("key reference", ref)
+	}
+	p := r.GetKeyProvider(ref[:i])
+	if p == nil {
+		return nil, errors.ErrUnknown(KIND_KEY_PROVIDER, ref[:i])
+	}
+	s, err := p.GetSigner(ref[i+1:])
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s %q", KIND_KEY_PROVIDER, ref[:i])
+	}
+	return s, nil
+}
+
+var defaultKeyProviderRegistry = NewKeyProviderRegistry()
+
+func DefaultKeyProviderRegistry() KeyProviderRegistry {
+	return defaultKeyProviderRegistry
+}
@@ -0,0 +1,145 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package exec
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+)
+
+// Scheme is the key reference scheme used for keys handled by
+// an external command.
+const Scheme = "exec"
+
+const (
+	CMD_PUBLIC_KEY = "public-key"
+	CMD_SIGN       = "sign"
+)
+
+func init() {
+	signing.DefaultKeyProviderRegistry().RegisterKeyProvider(Provider{})
+}
+
+// Provider is a signing.KeyProvider using an external command to sign
+// digests. The key reference has the form exec:<command> {<arg>}.
+//
+// The command is called with the additional arguments
+//   - public-key: to write the PEM encoded public key or certificate to stdout.
+//   - sign <hash>: to sign the digest read from stdin and write the raw signature
+//     to stdout. The hash is the lower case name of the used hash function
+//     without dashes (for example sha256), or none for signature algorithms
+//     signing the digest as message.
+type Provider struct{}
+
+var _ signing.KeyProvider = Provider{}
+
+func (Provider) Scheme() string {
+	return Scheme
+}
+
+func (Provider) GetSigner(spec string) (crypto.Signer, error) {
+	args := strings.Fields(spec)
+	if len(args) == 0 {
+		return nil, errors.Newf("command required for exec key reference")
+	}
+	return NewKey(args[0], args[1:]...)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Key is a crypto.Signer delegating the signing to an external command.
+type Key struct {
+	command string
+	args    []string
+	public  crypto.PublicKey
+}
+
+var _ crypto.Signer = (*Key)(nil)
+
+// NewKey provides a signer for the key handled by the given command.
+// The public key is requested from the command.
+func NewKey(command string, args ...string) (*Key, error) {
+	k := &Key{command: command, args: args}
+	data, err := k.run(nil, CMD_PUBLIC_KEY)
+	if err != nil {
+		return nil, err
+	}
+	k.public, err = ParsePublicKey(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid public key provided by %q", command)
+	}
+	return k, nil
+}
+
+func (k *Key) Public() crypto.PublicKey {
+	return k.public
+}
+
+func (k *Key) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return k.run(digest, CMD_SIGN, HashName(opts.HashFunc()))
+}
+
+func (k *Key) run(in []byte, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(k.command, append(append([]string{}, k.args...), args...)...)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, errors.Wrapf(err, "%s %s failed: %s", k.command, args[0], msg)
+		}
+		return nil, errors.Wrapf(err, "%s %s failed", k.command, args[0])
+	}
+	return stdout.Bytes(), nil
+}
+
+// HashName provides the hash name passed to the sign command.
+func HashName(h crypto.Hash) string {
+	if h == 0 {
+		return "none"
+	}
+	return strings.ToLower(strings.ReplaceAll(h.String(), "-", ""))
+}
+
+// ParsePublicKey parses a PEM encoded public key or certificate.
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.Newf("no PEM block found")
+	}
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	}
+}
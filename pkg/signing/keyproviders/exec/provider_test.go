@@ -0,0 +1,78 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package exec_test
+
+import (
+	"crypto"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+	"github.com/open-component-model/ocm/pkg/signing/keyproviders/exec"
+)
+
+var _ = Describe("exec key provider", func() {
+	var ref string
+	var pub interface{}
+
+	BeforeEach(func() {
+		priv, p, err := rsa.Handler{}.CreateKeyPair()
+		Expect(err).To(Succeed())
+		pub = p
+
+		data, err := rsa.KeyData(priv)
+		Expect(err).To(Succeed())
+		file := filepath.Join(GinkgoT().TempDir(), "key")
+		Expect(os.WriteFile(file, data, 0o600)).To(Succeed())
+		os.Setenv(ENV_KEY, file)
+
+		ref = exec.Scheme + ":" + os.Args[0]
+	})
+
+	AfterEach(func() {
+		os.Unsetenv(ENV_KEY)
+	})
+
+	It("is registered", func() {
+		Expect(signing.DefaultKeyProviderRegistry().IsKeyReference(ref)).To(BeTrue())
+		Expect(signing.DefaultKeyProviderRegistry().IsKeyReference("/tmp/key")).To(BeFalse())
+	})
+
+	It("provides public key", func() {
+		signer, err := signing.DefaultKeyProviderRegistry().ResolveKeyReference(ref)
+		Expect(err).To(Succeed())
+		Expect(signer.Public()).To(Equal(pub))
+	})
+
+	It("signs digest", func() {
+		signer, err := signing.DefaultKeyProviderRegistry().ResolveKeyReference(ref)
+		Expect(err).To(Succeed())
+
+		digest := "77dbfbdc2eb6e4e3ee3c0ed9e7ab7ba8c1658c8ca0a8d1bbb3e0bd8f6eb2de0b"
+		sig, err := rsa.Handler{}.Sign(digest, crypto.SHA256, "", signer)
+		Expect(err).To(Succeed())
+		Expect(rsa.Handler{}.Verify(digest, crypto.SHA256, sig, pub)).To(Succeed())
+	})
+
+	It("reports command errors", func() {
+		_, err := signing.DefaultKeyProviderRegistry().ResolveKeyReference(ref + " extra")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid arguments [extra public-key]"))
+	})
+})
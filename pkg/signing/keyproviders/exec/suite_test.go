@@ -0,0 +1,74 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package exec_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+	"github.com/open-component-model/ocm/pkg/signing/keyproviders/exec"
+)
+
+// ENV_KEY is used to run the test binary as signing command
+// using the private key found in the given file.
+const ENV_KEY = "OCM_TEST_EXEC_KEY"
+
+func TestMain(m *testing.M) {
+	if file := os.Getenv(ENV_KEY); file != "" {
+		os.Exit(command(file, os.Args[1:]))
+	}
+	os.Exit(m.Run())
+}
+
+func command(file string, args []string) int {
+	data, err := os.ReadFile(file)
+	if err == nil {
+		var key *rsa.PrivateKey
+		key, err = rsa.ParsePrivateKey(data)
+		if err == nil {
+			switch {
+			case len(args) == 1 && args[0] == exec.CMD_PUBLIC_KEY:
+				err = rsa.WriteKeyData(&key.PublicKey, os.Stdout)
+			case len(args) == 2 && args[0] == exec.CMD_SIGN && args[1] == exec.HashName(crypto.SHA256):
+				var digest, sig []byte
+				digest, err = io.ReadAll(os.Stdin)
+				if err == nil {
+					sig, err = key.Sign(rand.Reader, digest, crypto.SHA256)
+					os.Stdout.Write(sig)
+				}
+			default:
+				err = fmt.Errorf("invalid arguments %v", args)
+			}
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		return 1
+	}
+	return 0
+}
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Exec Key Provider")
+}
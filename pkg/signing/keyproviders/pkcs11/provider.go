@@ -0,0 +1,282 @@
+//go:build cgo
+
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkcs11
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/asn1"
+	"io"
+	"math/big"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+)
+
+func init() {
+	signing.DefaultKeyProviderRegistry().RegisterKeyProvider(Provider{})
+}
+
+// Provider is a signing.KeyProvider for keys kept by a PKCS#11 module.
+// RSA (PKCS #1 v1.5) and ECDSA keys are supported.
+type Provider struct{}
+
+var _ signing.KeyProvider = Provider{}
+
+func (Provider) Scheme() string {
+	return Scheme
+}
+
+func (Provider) GetSigner(spec string) (crypto.Signer, error) {
+	uri, err := ParseURI(spec)
+	if err != nil {
+		return nil, err
+	}
+	return NewKey(uri)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Key is a crypto.Signer for a private key kept by a PKCS#11 module.
+// A new module session is used for every operation.
+type Key struct {
+	uri    *URI
+	public crypto.PublicKey
+}
+
+var _ crypto.Signer = (*Key)(nil)
+
+// NewKey provides a signer for the key described by the URI.
+// The public key is read from the token.
+func NewKey(uri *URI) (*Key, error) {
+	k := &Key{uri: uri}
+	err := k.session(func(ctx *pkcs11.Ctx, s pkcs11.SessionHandle) error {
+		o, err := k.findObject(ctx, s, pkcs11.CKO_PUBLIC_KEY)
+		if err != nil {
+			return err
+		}
+		k.public, err = publicKey(ctx, s, o)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (k *Key) Public() crypto.PublicKey {
+	return k.public
+}
+
+func (k *Key) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var mech uint
+	var data []byte
+
+	switch k.public.(type) {
+	case *rsa.PublicKey:
+		if _, ok := opts.(*rsa.PSSOptions); ok {
+			return nil, errors.ErrNotSupported("RSA PSS signatures")
+		}
+		prefix, ok := hashPrefixes[opts.HashFunc()]
+		if !ok {
+			return nil, errors.ErrNotSupported("hash function", opts.HashFunc().String())
+		}
+		mech = pkcs11.CKM_RSA_PKCS
+		data = append(append([]byte{}, prefix...), digest...)
+	case *ecdsa.PublicKey:
+		mech = pkcs11.CKM_ECDSA
+		data = digest
+	default:
+		return nil, errors.ErrNotSupported("key type")
+	}
+
+	var sig []byte
+	err := k.session(func(ctx *pkcs11.Ctx, s pkcs11.SessionHandle) error {
+		o, err := k.findObject(ctx, s, pkcs11.CKO_PRIVATE_KEY)
+		if err != nil {
+			return err
+		}
+		err = ctx.SignInit(s, []*pkcs11.Mechanism{pkcs11.NewMechanism(mech, nil)}, o)
+		if err != nil {
+			return errors.Wrapf(err, "cannot initialize signing")
+		}
+		sig, err = ctx.Sign(s, data)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if mech == pkcs11.CKM_ECDSA {
+		// PKCS#11 provides the plain concatenation of r and s,
+		// but ASN.1 encoding is expected.
+		n := len(sig) / 2
+		return asn1.Marshal(struct{ R, S *big.Int }{
+			new(big.Int).SetBytes(sig[:n]),
+			new(big.Int).SetBytes(sig[n:]),
+		})
+	}
+	return sig, nil
+}
+
+func (k *Key) session(f func(ctx *pkcs11.Ctx, s pkcs11.SessionHandle) error) error {
+	ctx := pkcs11.New(k.uri.Module)
+	if ctx == nil {
+		return errors.Newf("cannot load pkcs11 module %q", k.uri.Module)
+	}
+	defer ctx.Destroy()
+
+	err := ctx.Initialize()
+	if err != nil && err != pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		return errors.Wrapf(err, "cannot initialize pkcs11 module %q", k.uri.Module)
+	}
+	defer ctx.Finalize()
+
+	slot, err := k.findSlot(ctx)
+	if err != nil {
+		return err
+	}
+	s, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return errors.Wrapf(err, "cannot open pkcs11 session")
+	}
+	defer ctx.CloseSession(s)
+
+	if k.uri.Pin != "" {
+		err = ctx.Login(s, pkcs11.CKU_USER, k.uri.Pin)
+		if err != nil && err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+			return errors.Wrapf(err, "cannot login to pkcs11 token")
+		}
+		defer ctx.Logout(s)
+	}
+	return f(ctx, s)
+}
+
+func (k *Key) findSlot(ctx *pkcs11.Ctx) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, errors.Wrapf(err, "cannot list pkcs11 slots")
+	}
+	for _, slot := range slots {
+		if k.uri.SlotID != nil && *k.uri.SlotID != slot {
+			continue
+		}
+		if k.uri.Token != "" {
+			info, err := ctx.GetTokenInfo(slot)
+			if err != nil || info.Label != k.uri.Token {
+				continue
+			}
+		}
+		return slot, nil
+	}
+	return 0, errors.ErrNotFound("pkcs11 token", k.uri.Token)
+}
+
+func (k *Key) findObject(ctx *pkcs11.Ctx, s pkcs11.SessionHandle, class uint) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+	}
+	if k.uri.Object != "" {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, k.uri.Object))
+	}
+	if k.uri.ID != nil {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_ID, k.uri.ID))
+	}
+	err := ctx.FindObjectsInit(s, template)
+	if err != nil {
+		return 0, errors.Wrapf(err, "cannot search pkcs11 objects")
+	}
+	objs, _, err := ctx.FindObjects(s, 2)
+	ctx.FindObjectsFinal(s)
+	if err != nil {
+		return 0, errors.Wrapf(err, "cannot search pkcs11 objects")
+	}
+	switch len(objs) {
+	case 0:
+		return 0, errors.ErrNotFound("pkcs11 key", k.uri.Object)
+	case 1:
+		return objs[0], nil
+	default:
+		return 0, errors.Newf("pkcs11 key %q is ambiguous", k.uri.Object)
+	}
+}
+
+func publicKey(ctx *pkcs11.Ctx, s pkcs11.SessionHandle, o pkcs11.ObjectHandle) (crypto.PublicKey, error) {
+	attrs, err := ctx.GetAttributeValue(s, o, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil)})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get pkcs11 key type")
+	}
+	switch {
+	case bytes.Equal(attrs[0].Value, pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_RSA).Value):
+		attrs, err = ctx.GetAttributeValue(s, o, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get rsa public key")
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(attrs[0].Value),
+			E: int(new(big.Int).SetBytes(attrs[1].Value).Int64()),
+		}, nil
+	case bytes.Equal(attrs[0].Value, pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC).Value):
+		attrs, err = ctx.GetAttributeValue(s, o, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get ecdsa public key")
+		}
+		var oid asn1.ObjectIdentifier
+		if _, err := asn1.Unmarshal(attrs[0].Value, &oid); err != nil {
+			return nil, errors.Wrapf(err, "invalid ec parameters")
+		}
+		curve := curves[oid.String()]
+		if curve == nil {
+			return nil, errors.ErrNotSupported("elliptic curve", oid.String())
+		}
+		var point []byte
+		if _, err := asn1.Unmarshal(attrs[1].Value, &point); err != nil {
+			return nil, errors.Wrapf(err, "invalid ec point")
+		}
+		x, y := elliptic.Unmarshal(curve, point)
+		if x == nil {
+			return nil, errors.Newf("invalid ec point")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.ErrNotSupported("pkcs11 key type")
+	}
+}
+
+var curves = map[string]elliptic.Curve{
+	"1.2.840.10045.3.1.7": elliptic.P256(),
+	"1.3.132.0.34":        elliptic.P384(),
+	"1.3.132.0.35":        elliptic.P521(),
+}
+
+// hashPrefixes are the ASN.1 DigestInfo prefixes required for
+// PKCS #1 v1.5 signatures.
+var hashPrefixes = map[crypto.Hash][]byte{
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
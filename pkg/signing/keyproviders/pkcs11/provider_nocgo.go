@@ -0,0 +1,43 @@
+//go:build !cgo
+
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkcs11
+
+import (
+	"crypto"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+)
+
+func init() {
+	signing.DefaultKeyProviderRegistry().RegisterKeyProvider(Provider{})
+}
+
+// Provider is a signing.KeyProvider for PKCS#11 key references.
+// Without cgo PKCS#11 modules cannot be loaded, therefore it
+// only reports that the support is missing.
+type Provider struct{}
+
+var _ signing.KeyProvider = Provider{}
+
+func (Provider) Scheme() string {
+	return Scheme
+}
+
+func (Provider) GetSigner(spec string) (crypto.Signer, error) {
+	return nil, errors.Newf("pkcs11 support not compiled in")
+}
@@ -0,0 +1,34 @@
+//go:build !cgo
+
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkcs11_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/signing"
+)
+
+var _ = Describe("pkcs11 key provider without cgo", func() {
+	It("reports missing support", func() {
+		ref := "pkcs11:token=ocm;object=signing-key?module-path=/usr/lib/softhsm/libsofthsm2.so"
+		Expect(signing.DefaultKeyProviderRegistry().IsKeyReference(ref)).To(BeTrue())
+		_, err := signing.DefaultKeyProviderRegistry().ResolveKeyReference(ref)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("pkcs11 support not compiled in"))
+	})
+})
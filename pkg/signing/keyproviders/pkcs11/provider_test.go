@@ -0,0 +1,148 @@
+//go:build cgo
+
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkcs11_test
+
+import (
+	"crypto"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+)
+
+const (
+	TOKEN  = "ocm"
+	OBJECT = "signing-key"
+	SOPIN  = "1234"
+	PIN    = "5678"
+)
+
+// softHSMModules are the typical locations of the SoftHSM module.
+// An explicit location can be given with the environment variable
+// SOFTHSM2_MODULE.
+var softHSMModules = []string{
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+	"/opt/homebrew/lib/softhsm/libsofthsm2.so",
+}
+
+func softHSMModule() string {
+	if m := os.Getenv("SOFTHSM2_MODULE"); m != "" {
+		return m
+	}
+	for _, m := range softHSMModules {
+		if _, err := os.Stat(m); err == nil {
+			return m
+		}
+	}
+	return ""
+}
+
+// initToken creates a SoftHSM token with an RSA key pair.
+func initToken(module string) {
+	dir := GinkgoT().TempDir()
+	conf := filepath.Join(dir, "softhsm2.conf")
+	Expect(os.Mkdir(filepath.Join(dir, "tokens"), 0o700)).To(Succeed())
+	Expect(os.WriteFile(conf, []byte(fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\n", filepath.Join(dir, "tokens"))), 0o600)).To(Succeed())
+	os.Setenv("SOFTHSM2_CONF", conf)
+
+	ctx := pkcs11.New(module)
+	Expect(ctx).NotTo(BeNil())
+	defer ctx.Destroy()
+	Expect(ctx.Initialize()).To(Succeed())
+	defer ctx.Finalize()
+
+	slots, err := ctx.GetSlotList(false)
+	Expect(err).To(Succeed())
+	Expect(ctx.InitToken(slots[0], SOPIN, TOKEN)).To(Succeed())
+
+	slots, err = ctx.GetSlotList(true)
+	Expect(err).To(Succeed())
+	var slot uint
+	for _, slot = range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		Expect(err).To(Succeed())
+		if info.Label == TOKEN {
+			break
+		}
+	}
+	s, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	Expect(err).To(Succeed())
+	defer ctx.CloseSession(s)
+
+	Expect(ctx.Login(s, pkcs11.CKU_SO, SOPIN)).To(Succeed())
+	Expect(ctx.InitPIN(s, PIN)).To(Succeed())
+	Expect(ctx.Logout(s)).To(Succeed())
+	Expect(ctx.Login(s, pkcs11.CKU_USER, PIN)).To(Succeed())
+	defer ctx.Logout(s)
+
+	_, _, err = ctx.GenerateKeyPair(s,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_KEY_PAIR_GEN, nil)},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_RSA),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, []byte{1, 0, 1}),
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS_BITS, 2048),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, OBJECT),
+		},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, OBJECT),
+		},
+	)
+	Expect(err).To(Succeed())
+}
+
+var _ = Describe("pkcs11 key provider", func() {
+	var ref string
+
+	BeforeEach(func() {
+		module := softHSMModule()
+		if module == "" {
+			Skip("SoftHSM module not found")
+		}
+		initToken(module)
+		ref = fmt.Sprintf("pkcs11:token=%s;object=%s?module-path=%s&pin-value=%s", TOKEN, OBJECT, module, PIN)
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("SOFTHSM2_CONF")
+	})
+
+	It("signs digest", func() {
+		signer, err := signing.DefaultKeyProviderRegistry().ResolveKeyReference(ref)
+		Expect(err).To(Succeed())
+
+		digest := "77dbfbdc2eb6e4e3ee3c0ed9e7ab7ba8c1658c8ca0a8d1bbb3e0bd8f6eb2de0b"
+		sig, err := rsa.Handler{}.Sign(digest, crypto.SHA256, "", signer)
+		Expect(err).To(Succeed())
+		Expect(rsa.Handler{}.Verify(digest, crypto.SHA256, sig, signer.Public())).To(Succeed())
+	})
+})
@@ -0,0 +1,115 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkcs11
+
+import (
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// Scheme is the key reference scheme used for keys kept by a PKCS#11 module.
+const Scheme = "pkcs11"
+
+// URI describes a key kept by a PKCS#11 module according to RFC 7512.
+// The following attributes are supported:
+//   - path attributes: token, object, id, slot-id
+//   - query attributes: module-path, pin-value, pin-source
+type URI struct {
+	Module string
+	Token  string
+	Object string
+	ID     []byte
+	SlotID *uint
+	Pin    string
+}
+
+// ParseURI parses the scheme specific part of a PKCS#11 URI.
+func ParseURI(spec string) (*URI, error) {
+	u := &URI{}
+	spec = strings.TrimPrefix(spec, Scheme+":")
+	path, query := spec, ""
+	if i := strings.Index(spec, "?"); i >= 0 {
+		path, query = spec[:i], spec[i+1:]
+	}
+	attrs, err := parseAttributes(path, ";")
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range attrs {
+		switch k {
+		case "token":
+			u.Token = v
+		case "object":
+			u.Object = v
+		case "id":
+			u.ID = []byte(v)
+		case "slot-id":
+			id, err := strconv.ParseUint(v, 10, 32)
+			if err != nil {
+				return nil, errors.ErrInvalidWrap(err, "slot-id", v)
+			}
+			slot := uint(id)
+			u.SlotID = &slot
+		}
+	}
+	attrs, err = parseAttributes(query, "&")
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range attrs {
+		switch k {
+		case "module-path":
+			u.Module = v
+		case "pin-value":
+			u.Pin = v
+		case "pin-source":
+			data, err := os.ReadFile(strings.TrimPrefix(v, "file:"))
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot read pin source %q", v)
+			}
+			u.Pin = strings.TrimSpace(string(data))
+		}
+	}
+	if u.Module == "" {
+		return nil, errors.Newf("module-path required for pkcs11 key reference")
+	}
+	if u.Object == "" && u.ID == nil {
+		return nil, errors.Newf("object or id required for pkcs11 key reference")
+	}
+	return u, nil
+}
+
+func parseAttributes(s string, sep string) (map[string]string, error) {
+	attrs := map[string]string{}
+	if s == "" {
+		return attrs, nil
+	}
+	for _, a := range strings.Split(s, sep) {
+		i := strings.Index(a, "=")
+		if i <= 0 {
+			return nil, errors.ErrInvalid("pkcs11 attribute", a)
+		}
+		v, err := url.PathUnescape(a[i+1:])
+		if err != nil {
+			return nil, errors.ErrInvalidWrap(err, "pkcs11 attribute", a)
+		}
+		attrs[a[:i]] = v
+	}
+	return attrs, nil
+}
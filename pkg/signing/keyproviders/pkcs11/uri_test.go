@@ -0,0 +1,59 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkcs11_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/signing/keyproviders/pkcs11"
+)
+
+var _ = Describe("pkcs11 uri", func() {
+	It("parses uri", func() {
+		uri, err := pkcs11.ParseURI("pkcs11:token=my%20token;object=key;id=%01;slot-id=2?module-path=/lib/module.so&pin-value=1234")
+		Expect(err).To(Succeed())
+		slot := uint(2)
+		Expect(uri).To(Equal(&pkcs11.URI{
+			Module: "/lib/module.so",
+			Token:  "my token",
+			Object: "key",
+			ID:     []byte{1},
+			SlotID: &slot,
+			Pin:    "1234",
+		}))
+	})
+
+	It("reads pin source", func() {
+		file := filepath.Join(GinkgoT().TempDir(), "pin")
+		Expect(os.WriteFile(file, []byte("5678\n"), 0o600)).To(Succeed())
+		uri, err := pkcs11.ParseURI("object=key?module-path=/lib/module.so&pin-source=file:" + file)
+		Expect(err).To(Succeed())
+		Expect(uri.Pin).To(Equal("5678"))
+	})
+
+	It("requires module", func() {
+		_, err := pkcs11.ParseURI("object=key")
+		Expect(err).To(MatchError("module-path required for pkcs11 key reference"))
+	})
+
+	It("requires key", func() {
+		_, err := pkcs11.ParseURI("token=test?module-path=/lib/module.so")
+		Expect(err).To(MatchError("object or id required for pkcs11 key reference"))
+	})
+})
@@ -15,11 +15,20 @@
 package signing_test
 
 import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/ecdsa"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/ed25519"
 	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/sigstore"
 	"github.com/open-component-model/ocm/pkg/signing/hasher/sha256"
 )
 
@@ -27,6 +36,12 @@ var registry = signing.DefaultRegistry()
 
 const NAME = "testsignature"
 
+// signer hides the private key behind the crypto.Signer interface
+// like the keys provided by a signing.KeyProvider.
+type signer struct {
+	crypto.Signer
+}
+
 var _ = Describe("normalization", func() {
 
 	It("Normalizes struct without excludes", func() {
@@ -50,4 +65,128 @@ var _ = Describe("normalization", func() {
 		hash = "A" + hash[1:]
 		Expect(registry.GetVerifier(rsa.Algorithm).Verify(hash, hasher.Crypto(), sig, registry.GetPublicKey(NAME))).To(HaveOccurred())
 	})
+	It("signs and verifies with ecdsa", func() {
+		hasher := registry.GetHasher(sha256.Algorithm)
+		hash, _ := signing.Hash(hasher.Create(), []byte("test"))
+
+		priv, pub, err := ecdsa.Handler{}.CreateKeyPair()
+		Expect(err).To(Succeed())
+		privData, err := ecdsa.KeyData(priv)
+		Expect(err).To(Succeed())
+		pubData, err := ecdsa.KeyData(pub)
+		Expect(err).To(Succeed())
+
+		sig, err := registry.GetSigner(ecdsa.Algorithm).Sign(hash, hasher.Crypto(), "mandelsoft", privData)
+		Expect(err).To(Succeed())
+		Expect(sig.MediaType).To(Equal(ecdsa.MediaType))
+		Expect(sig.Algorithm).To(Equal(ecdsa.Algorithm))
+
+		Expect(registry.GetVerifier(ecdsa.Algorithm).Verify(hash, hasher.Crypto(), sig, pubData)).To(Succeed())
+		hash = "A" + hash[1:]
+		Expect(registry.GetVerifier(ecdsa.Algorithm).Verify(hash, hasher.Crypto(), sig, pubData)).To(HaveOccurred())
+	})
+
+	It("signs and verifies with ed25519", func() {
+		hasher := registry.GetHasher(sha256.Algorithm)
+		hash, _ := signing.Hash(hasher.Create(), []byte("test"))
+
+		priv, pub, err := ed25519.Handler{}.CreateKeyPair()
+		Expect(err).To(Succeed())
+		privData, err := ed25519.KeyData(priv)
+		Expect(err).To(Succeed())
+		pubData, err := ed25519.KeyData(pub)
+		Expect(err).To(Succeed())
+
+		sig, err := registry.GetSigner(ed25519.Algorithm).Sign(hash, hasher.Crypto(), "mandelsoft", privData)
+		Expect(err).To(Succeed())
+		Expect(sig.MediaType).To(Equal(ed25519.MediaType))
+		Expect(sig.Algorithm).To(Equal(ed25519.Algorithm))
+
+		Expect(registry.GetVerifier(ed25519.Algorithm).Verify(hash, hasher.Crypto(), sig, pubData)).To(Succeed())
+		hash = "A" + hash[1:]
+		Expect(registry.GetVerifier(ed25519.Algorithm).Verify(hash, hasher.Crypto(), sig, pubData)).To(HaveOccurred())
+	})
+
+	Context("sigstore", func() {
+		var hasher signing.Hasher
+		var hash string
+
+		BeforeEach(func() {
+			hasher = registry.GetHasher(sha256.Algorithm)
+			hash, _ = signing.Hash(hasher.Create(), []byte("test"))
+		})
+
+		It("signs and verifies with public key", func() {
+			priv, pub, err := sigstore.Handler{}.CreateKeyPair()
+			Expect(err).To(Succeed())
+
+			sig, err := registry.GetSigner(sigstore.Algorithm).Sign(hash, hasher.Crypto(), "", priv)
+			Expect(err).To(Succeed())
+			Expect(sig.MediaType).To(Equal(sigstore.MediaType))
+
+			bundle, err := sigstore.ParseBundle([]byte(sig.Value))
+			Expect(err).To(Succeed())
+			Expect(bundle.MessageSignature.MessageDigest.Algorithm).To(Equal("SHA2_256"))
+			Expect(bundle.VerificationMaterial.PublicKey).NotTo(BeNil())
+			Expect(bundle.VerificationMaterial.X509CertificateChain).To(BeNil())
+
+			Expect(registry.GetVerifier(sigstore.Algorithm).Verify(hash, hasher.Crypto(), sig, pub)).To(Succeed())
+			_, other, err := sigstore.Handler{}.CreateKeyPair()
+			Expect(err).To(Succeed())
+			Expect(registry.GetVerifier(sigstore.Algorithm).Verify(hash, hasher.Crypto(), sig, other)).To(HaveOccurred())
+			hash = "A" + hash[1:]
+			Expect(registry.GetVerifier(sigstore.Algorithm).Verify(hash, hasher.Crypto(), sig, pub)).To(HaveOccurred())
+		})
+
+		It("signs with signer provided for external key", func() {
+			priv, pub, err := sigstore.Handler{}.CreateKeyPair()
+			Expect(err).To(Succeed())
+
+			sig, err := registry.GetSigner(sigstore.Algorithm).Sign(hash, hasher.Crypto(), "", signer{priv.(crypto.Signer)})
+			Expect(err).To(Succeed())
+			bundle, err := sigstore.ParseBundle([]byte(sig.Value))
+			Expect(err).To(Succeed())
+			Expect(bundle.VerificationMaterial.PublicKey).NotTo(BeNil())
+
+			Expect(registry.GetVerifier(sigstore.Algorithm).Verify(hash, hasher.Crypto(), sig, pub)).To(Succeed())
+		})
+
+		It("signs and verifies with certificate chain against local trust root", func() {
+			capriv, capub, err := ecdsa.Handler{}.CreateKeyPair()
+			Expect(err).To(Succeed())
+			caData, err := signing.CreateCertificate(pkix.Name{CommonName: "ca-authority"}, nil, 10*time.Hour, capub, nil, capriv, true)
+			Expect(err).To(Succeed())
+			ca, err := x509.ParseCertificate(caData)
+			Expect(err).To(Succeed())
+
+			priv, pub, err := ecdsa.Handler{}.CreateKeyPair()
+			Expect(err).To(Succeed())
+			certData, err := signing.CreateCertificate(pkix.Name{CommonName: "mandelsoft"}, nil, 10*time.Hour, pub, ca, capriv, false)
+			Expect(err).To(Succeed())
+
+			key, err := ecdsa.KeyData(priv)
+			Expect(err).To(Succeed())
+			key = append(key, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certData})...)
+			root := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caData})
+
+			sig, err := registry.GetSigner(sigstore.Algorithm).Sign(hash, hasher.Crypto(), "mandelsoft", key)
+			Expect(err).To(Succeed())
+			bundle, err := sigstore.ParseBundle([]byte(sig.Value))
+			Expect(err).To(Succeed())
+			Expect(len(bundle.VerificationMaterial.X509CertificateChain.Certificates)).To(Equal(1))
+
+			Expect(registry.GetVerifier(sigstore.Algorithm).Verify(hash, hasher.Crypto(), sig, root)).To(Succeed())
+
+			sig.Issuer = "other"
+			Expect(registry.GetVerifier(sigstore.Algorithm).Verify(hash, hasher.Crypto(), sig, root)).To(HaveOccurred())
+
+			sig.Issuer = "mandelsoft"
+			_, opub, err := ecdsa.Handler{}.CreateKeyPair()
+			Expect(err).To(Succeed())
+			otherData, err := signing.CreateCertificate(pkix.Name{CommonName: "ca-authority"}, nil, 10*time.Hour, opub, nil, capriv, true)
+			Expect(err).To(Succeed())
+			other := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: otherData})
+			Expect(registry.GetVerifier(sigstore.Algorithm).Verify(hash, hasher.Crypto(), sig, other)).To(HaveOccurred())
+		})
+	})
 })
@@ -0,0 +1,84 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package tsa
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/asn1"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/digitorus/timestamp"
+)
+
+// DefaultPolicy is the policy used by the Server, if no policy is given.
+var DefaultPolicy = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 2, 3, 4, 1}
+
+// Server is a minimal RFC 3161 timestamp authority, which can be used
+// as local replacement of a TSA, for example for tests.
+// Its certificate must be restricted to timestamping to be accepted
+// by Verify (see signing.CreateTimestampingCertificate).
+type Server struct {
+	Certificate *x509.Certificate
+	Signer      crypto.Signer
+	Policy      asn1.ObjectIdentifier
+	// Now provides the time used for timestamps. If not set,
+	// the current time is used.
+	Now func() time.Time
+}
+
+var _ http.Handler = (*Server)(nil)
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req, err := timestamp.ParseRequest(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	now := time.Now()
+	if s.Now != nil {
+		now = s.Now()
+	}
+	policy := s.Policy
+	if policy == nil {
+		policy = DefaultPolicy
+	}
+	ts := &timestamp.Timestamp{
+		HashAlgorithm:     req.HashAlgorithm,
+		HashedMessage:     req.HashedMessage,
+		Time:              now,
+		Nonce:             req.Nonce,
+		Policy:            policy,
+		AddTSACertificate: req.Certificates,
+	}
+	resp, err := ts.CreateResponse(s.Certificate, s.Signer)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", MediaTypeReply)
+	w.Write(resp)
+}
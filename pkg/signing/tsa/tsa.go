@@ -0,0 +1,158 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package tsa provides access to RFC 3161 timestamp authorities (TSA)
+// to request and verify timestamp tokens for signatures.
+package tsa
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/digitorus/pkcs7"
+	"github.com/digitorus/timestamp"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+)
+
+const (
+	MediaTypeQuery = "application/timestamp-query"
+	MediaTypeReply = "application/timestamp-reply"
+)
+
+const KIND_TIMESTAMP = "timestamp"
+
+// Hash is the hash function used for the message imprint of
+// timestamp requests.
+const Hash = crypto.SHA256
+
+type Timestamp = timestamp.Timestamp
+
+// Request requests a timestamp token for a message from the
+// timestamp authority found at the given URL.
+func Request(url string, data []byte) (*Timestamp, error) {
+	req, err := timestamp.CreateRequest(bytes.NewReader(data), &timestamp.RequestOptions{
+		Hash:         Hash,
+		Certificates: true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot create timestamp request")
+	}
+	resp, err := http.Post(url, MediaTypeQuery, bytes.NewReader(req))
+	if err != nil {
+		return nil, errors.Wrapf(err, "timestamp request to %s failed", url)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read timestamp response from %s", url)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Newf("timestamp request to %s failed: %s", url, resp.Status)
+	}
+	ts, err := timestamp.ParseResponse(body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid timestamp response from %s", url)
+	}
+	if err := checkImprint(ts, data); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// Verify verifies a DER encoded timestamp token for a message and provides
+// the timestamp. The token must be signed by a single timestamp authority
+// whose certificate is restricted to timestamping (RFC 3161 section 2.3).
+// Its certificate chain is verified for the time of the timestamp against
+// the given root certificates of trusted timestamp authorities.
+// If no roots are given, the system root certificates are used.
+func Verify(token []byte, data []byte, roots *x509.CertPool) (*Timestamp, error) {
+	ts, err := timestamp.Parse(token)
+	if err != nil {
+		return nil, errors.ErrInvalidWrap(err, KIND_TIMESTAMP)
+	}
+	if err := checkImprint(ts, data); err != nil {
+		return nil, err
+	}
+	p7, err := pkcs7.Parse(token)
+	if err != nil {
+		return nil, errors.ErrInvalidWrap(err, KIND_TIMESTAMP)
+	}
+	if len(p7.Certificates) == 0 {
+		return nil, errors.Newf("timestamp contains no certificate of timestamp authority")
+	}
+	cert := p7.GetOnlySigner()
+	if cert == nil {
+		return nil, errors.Newf("timestamp must be signed by exactly one timestamp authority")
+	}
+	if err := checkTimestamping(cert); err != nil {
+		return nil, err
+	}
+	if roots == nil {
+		roots, err = signing.BaseRootPool()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := p7.VerifyWithChainAtTime(roots, ts.Time); err != nil {
+		return nil, errors.Wrapf(err, "cannot verify timestamp authority")
+	}
+	// the chain check of the pkcs7 signature accepts any extended
+	// key usage, therefore the chain is verified for timestamping, again.
+	intermediates := x509.NewCertPool()
+	for _, c := range p7.Certificates {
+		intermediates.AddCert(c)
+	}
+	_, err = cert.Verify(x509.VerifyOptions{
+		Intermediates: intermediates,
+		Roots:         roots,
+		CurrentTime:   ts.Time,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot verify timestamp authority")
+	}
+	return ts, nil
+}
+
+// checkTimestamping checks whether a certificate is dedicated to
+// timestamping.
+func checkTimestamping(cert *x509.Certificate) error {
+	if len(cert.ExtKeyUsage) != 1 || cert.ExtKeyUsage[0] != x509.ExtKeyUsageTimeStamping || len(cert.UnknownExtKeyUsage) != 0 {
+		return errors.Newf("certificate %q of timestamp authority is not restricted to timestamping", cert.Subject.String())
+	}
+	return nil
+}
+
+func checkImprint(ts *Timestamp, data []byte) error {
+	if !ts.HashAlgorithm.Available() {
+		return errors.ErrNotSupported("timestamp hash algorithm", ts.HashAlgorithm.String())
+	}
+	h := ts.HashAlgorithm.New()
+	h.Write(data)
+	if !bytes.Equal(h.Sum(nil), ts.HashedMessage) {
+		return errors.Newf("timestamp does not match signature")
+	}
+	return nil
+}
+
+// Valid checks whether a certificate is valid at the time of a timestamp.
+func Valid(cert *x509.Certificate, ts time.Time) bool {
+	return !ts.Before(cert.NotBefore) && !ts.After(cert.NotAfter)
+}
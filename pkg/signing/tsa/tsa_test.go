@@ -0,0 +1,124 @@
+// Copyright 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package tsa_test
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+	"github.com/open-component-model/ocm/pkg/signing/tsa"
+)
+
+var _ = Describe("timestamp authority", func() {
+	validFrom := time.Now().Add(-5 * time.Hour)
+
+	capriv, capub, err := rsa.Handler{}.CreateKeyPair()
+	Expect(err).To(Succeed())
+	caData, err := signing.CreateTimestampingCertificate(pkix.Name{CommonName: "ca-authority"}, &validFrom, 10*time.Hour, capub, nil, capriv, true)
+	Expect(err).To(Succeed())
+	ca, err := x509.ParseCertificate(caData)
+	Expect(err).To(Succeed())
+
+	priv, pub, err := rsa.Handler{}.CreateKeyPair()
+	Expect(err).To(Succeed())
+	certData, err := signing.CreateTimestampingCertificate(pkix.Name{CommonName: "tsa"}, &validFrom, 10*time.Hour, pub, ca, capriv, false)
+	Expect(err).To(Succeed())
+	cert, err := x509.ParseCertificate(certData)
+	Expect(err).To(Succeed())
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+
+	var server *httptest.Server
+	now := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(&tsa.Server{
+			Certificate: cert,
+			Signer:      priv.(crypto.Signer),
+			Now:         func() time.Time { return now },
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("requests and verifies timestamp", func() {
+		ts, err := tsa.Request(server.URL, []byte("signature"))
+		Expect(err).To(Succeed())
+		Expect(ts.Time).To(Equal(now))
+
+		ts, err = tsa.Verify(ts.RawToken, []byte("signature"), pool)
+		Expect(err).To(Succeed())
+		Expect(ts.Time).To(Equal(now))
+	})
+
+	It("rejects timestamp for other message", func() {
+		ts, err := tsa.Request(server.URL, []byte("signature"))
+		Expect(err).To(Succeed())
+
+		_, err = tsa.Verify(ts.RawToken, []byte("other"), pool)
+		Expect(err).To(MatchError("timestamp does not match signature"))
+	})
+
+	It("rejects untrusted timestamp authority", func() {
+		ts, err := tsa.Request(server.URL, []byte("signature"))
+		Expect(err).To(Succeed())
+
+		_, err = tsa.Verify(ts.RawToken, []byte("signature"), x509.NewCertPool())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("cannot verify timestamp authority"))
+	})
+
+	It("rejects backdated timestamp of certificate not restricted to timestamping", func() {
+		codepriv, codepub, err := rsa.Handler{}.CreateKeyPair()
+		Expect(err).To(Succeed())
+		codecaData, err := signing.CreateCertificate(pkix.Name{CommonName: "code-authority"}, &validFrom, 10*time.Hour, codepub, nil, codepriv, true)
+		Expect(err).To(Succeed())
+		codeca, err := x509.ParseCertificate(codecaData)
+		Expect(err).To(Succeed())
+		codepool := x509.NewCertPool()
+		codepool.AddCert(codeca)
+
+		signerpriv, signerpub, err := rsa.Handler{}.CreateKeyPair()
+		Expect(err).To(Succeed())
+		signerData, err := signing.CreateCertificate(pkix.Name{CommonName: "signer"}, &validFrom, 10*time.Hour, signerpub, codeca, codepriv, false)
+		Expect(err).To(Succeed())
+		signer, err := x509.ParseCertificate(signerData)
+		Expect(err).To(Succeed())
+
+		forged := httptest.NewServer(&tsa.Server{
+			Certificate: signer,
+			Signer:      signerpriv.(crypto.Signer),
+			Now:         func() time.Time { return validFrom.Add(-time.Hour).UTC().Truncate(time.Second) },
+		})
+		defer forged.Close()
+
+		ts, err := tsa.Request(forged.URL, []byte("signature"))
+		Expect(err).To(Succeed())
+
+		_, err = tsa.Verify(ts.RawToken, []byte("signature"), codepool)
+		Expect(err).To(MatchError(`certificate "CN=signer" of timestamp authority is not restricted to timestamping`))
+	})
+})
@@ -22,6 +22,7 @@ import (
 	pathutil "path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/mandelsoft/vfs/pkg/vfs"
 )
@@ -34,10 +35,18 @@ type TarFileSystemOptions struct {
 	// Only supported for Type dir.
 	PreserveDir    bool
 	FollowSymlinks bool
+	// Reproducible defines that the archive should only depend on the
+	// file names, types and contents. File modes, ownership and
+	// timestamps of the filesystem are normalized.
+	Reproducible bool
 
 	root string
 }
 
+// ReproducibleModTime is the modification time used for all
+// entries of a reproducible archive.
+var ReproducibleModTime = time.Unix(0, 0).UTC()
+
 // Included determines whether a file should be included.
 func (opts *TarFileSystemOptions) Included(path string) (bool, error) {
 	// if a root path is given remove it rom the path to be checked
@@ -104,6 +113,9 @@ func addFileToTar(fs vfs.FileSystem, tw *tar.Writer, path string, realPath strin
 		return err
 	}
 	header.Name = path
+	if opts.Reproducible {
+		normalizeHeader(header)
+	}
 
 	switch {
 	case info.IsDir():
@@ -113,27 +125,20 @@ func addFileToTar(fs vfs.FileSystem, tw *tar.Writer, path string, realPath strin
 				return fmt.Errorf("unable to write header for %q: %w", path, err)
 			}
 		}
-		err := vfs.Walk(fs, realPath, func(subFilePath string, info os.FileInfo, err error) error {
-			if subFilePath == realPath {
-				return nil
-			}
-			if err != nil {
-				return err
-			}
-			relPath, err := filepath.Rel(realPath, subFilePath)
-			if err != nil {
-				return fmt.Errorf("unable to calculate relative path for %s: %w", subFilePath, err)
-			}
-			err = addFileToTar(fs, tw, pathutil.Join(path, relPath), subFilePath, opts)
+		// vfs.ReadDir provides the entries in lexical order,
+		// so the order of the archive entries is stable.
+		entries, err := vfs.ReadDir(fs, realPath)
+		if err != nil {
+			return fmt.Errorf("unable to read directory %q: %w", realPath, err)
+		}
+		for _, e := range entries {
+			subFilePath := vfs.Join(fs, realPath, e.Name())
+			err = addFileToTar(fs, tw, pathutil.Join(path, e.Name()), subFilePath, opts)
 			if err != nil {
 				return fmt.Errorf("failed to tar the input from %q: %w", subFilePath, err)
 			}
-			if info.IsDir() {
-				return vfs.SkipDir
-			}
-			return nil
-		})
-		return err
+		}
+		return nil
 	case info.Mode().IsRegular():
 		if err := tw.WriteHeader(header); err != nil {
 			return fmt.Errorf("unable to write header for %q: %w", path, err)
@@ -164,3 +169,21 @@ func addFileToTar(fs vfs.FileSystem, tw *tar.Writer, path string, realPath strin
 		return fmt.Errorf("unsupported file type %s in %s", info.Mode().String(), path)
 	}
 }
+
+// normalizeHeader removes all host specific information from a tar header.
+// Directories and executable files get the mode 0755, all other files 0644.
+func normalizeHeader(header *tar.Header) {
+	header.Uid = 0
+	header.Gid = 0
+	header.Uname = ""
+	header.Gname = ""
+	header.ModTime = ReproducibleModTime
+	header.AccessTime = time.Time{}
+	header.ChangeTime = time.Time{}
+	header.PAXRecords = nil
+	if header.Typeflag == tar.TypeDir || header.Mode&0o111 != 0 {
+		header.Mode = 0o755
+	} else {
+		header.Mode = 0o644
+	}
+}